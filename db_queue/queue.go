@@ -0,0 +1,345 @@
+// Package db_queue is a queue backend that keeps the messages in the queue_jobs table of the database, so the service
+// can run with the database only. Jobs are claimed with FOR UPDATE SKIP LOCKED, so any number of consumers can share
+// a queue. A claimed job is hidden for the visibility timeout, and delivered again if it was not acked by then
+package db_queue
+
+import (
+	"context"
+	"encoding/json"
+	"entgo.io/ent/dialect/sql"
+	"errors"
+	"fmt"
+	"github.com/Av1shay/timers-scheduler-demo/ent"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+	"github.com/Av1shay/timers-scheduler-demo/task"
+	"github.com/Av1shay/timers-scheduler-demo/workerpool"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultVisibilityTimeout has to be longer than the time it takes to handle a message
+	DefaultVisibilityTimeout = time.Minute
+	// DefaultMaxDeliveries is the number of times a job is delivered before it's dead lettered
+	DefaultMaxDeliveries = 5
+
+	// pollInterval is how often an idle consumer looks for jobs, jobs published by the same process wake it up
+	pollInterval = 500 * time.Millisecond
+)
+
+// ErrVisibilityExpired is returned when acking or nacking a job whose visibility timeout expired,
+// the job may have been delivered again
+var ErrVisibilityExpired = errors.New("visibility timeout of the job expired")
+
+type Queue struct {
+	dbClient          *ent.Client
+	name              string
+	workers           int
+	visibilityTimeout time.Duration
+	maxDeliveries     int
+
+	// pool runs the consumers, it's woken up when a job is published
+	pool *workerpool.Pool
+}
+
+// Option configures optional behaviour of the Queue
+type Option func(q *Queue)
+
+// WithWorkers sets the number of jobs handled concurrently by every consumer
+func WithWorkers(workers int) Option {
+	return func(q *Queue) {
+		q.workers = workers
+	}
+}
+
+// WithVisibilityTimeout sets for how long a claimed job is hidden from other consumers
+func WithVisibilityTimeout(d time.Duration) Option {
+	return func(q *Queue) {
+		q.visibilityTimeout = d
+	}
+}
+
+// WithMaxDeliveries sets the number of times a job is delivered before it's dead lettered
+func WithMaxDeliveries(n int) Option {
+	return func(q *Queue) {
+		q.maxDeliveries = n
+	}
+}
+
+// New creates the queue with the given name, queues with different names share the table
+func New(dbClient *ent.Client, name string, opts ...Option) *Queue {
+	q := &Queue{
+		dbClient:          dbClient,
+		name:              name,
+		workers:           workerpool.DefaultWorkers,
+		visibilityTimeout: DefaultVisibilityTimeout,
+		maxDeliveries:     DefaultMaxDeliveries,
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+	q.pool = workerpool.New(q.workers, pollInterval)
+	return q
+}
+
+func (q *Queue) Publish(ctx context.Context, t *task.Task) error {
+	errs := q.PublishBatch(ctx, []*task.Task{t})
+	return errs[0]
+}
+
+// PublishBatch inserts the jobs of all the tasks in a single statement, so they are all published or all fail
+func (q *Queue) PublishBatch(ctx context.Context, tasks []*task.Task) []error {
+	errs := make([]error, len(tasks))
+	creators := make([]*ent.QueueJobCreate, 0, len(tasks))
+	n := time.Now().UTC()
+	for i, t := range tasks {
+		b, err := json.Marshal(t)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		creators = append(creators, q.dbClient.QueueJob.Create().SetQueue(q.name).SetPayload(b).SetVisibleAt(n))
+	}
+	if len(creators) == 0 {
+		return errs
+	}
+	if err := q.dbClient.QueueJob.CreateBulk(creators...).Exec(ctx); err != nil {
+		for i := range errs {
+			if errs[i] == nil {
+				errs[i] = err
+			}
+		}
+		return errs
+	}
+	q.pool.Wake()
+	return errs
+}
+
+// Consume calls handler for every job from a pool of workers, so handler is called concurrently.
+// handler must ack the job or nack it without requeue to dead letter it.
+// Jobs are claimed only when a worker is idle, so their visibility timeout starts when they are handled
+func (q *Queue) Consume(handler func(m task.Message)) error {
+	return q.pool.Consume(q.fetch, handler)
+}
+
+// fetch claims up to n jobs as messages
+func (q *Queue) fetch(ctx context.Context, n int) ([]task.Message, error) {
+	jobs, err := q.claim(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]task.Message, len(jobs))
+	for i, job := range jobs {
+		msgs[i] = &message{job: job, q: q}
+	}
+	return msgs, nil
+}
+
+// claim hides up to limit visible jobs for the visibility timeout and returns them. Jobs that were delivered
+// the max times are dead lettered instead, since their handler crashed or timed out every time
+func (q *Queue) claim(ctx context.Context, limit int) ([]*ent.QueueJob, error) {
+	tx, err := q.dbClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n := time.Now().UTC()
+	jobs, err := tx.QueueJob.
+		Query().
+		Where(queuejob.Queue(q.name), queuejob.DeadAtIsNil(), queuejob.VisibleAtLTE(n)).
+		Order(ent.Asc(queuejob.FieldVisibleAt), ent.Asc(queuejob.FieldID)).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if len(jobs) == 0 {
+		return nil, tx.Rollback()
+	}
+
+	claimed := make([]*ent.QueueJob, 0, len(jobs))
+	claimedIDs := make([]int, 0, len(jobs))
+	deadIDs := make([]int, 0)
+	for _, job := range jobs {
+		if job.Deliveries >= q.maxDeliveries {
+			deadIDs = append(deadIDs, job.ID)
+			continue
+		}
+		job.Deliveries++
+		claimed = append(claimed, job)
+		claimedIDs = append(claimedIDs, job.ID)
+	}
+	if len(claimedIDs) > 0 {
+		err := tx.QueueJob.Update().
+			Where(queuejob.IDIn(claimedIDs...)).
+			SetVisibleAt(n.Add(q.visibilityTimeout)).
+			AddDeliveries(1).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if len(deadIDs) > 0 {
+		err := tx.QueueJob.Update().
+			Where(queuejob.IDIn(deadIDs...)).
+			SetDeadAt(n).
+			AddDeadCount(1).
+			SetDeadReason(fmt.Sprintf("delivered %d times without ack", q.maxDeliveries)).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// Shutdown stops consuming and waits for the jobs that are being handled until ctx is done
+func (q *Queue) Shutdown(ctx context.Context) error {
+	return q.pool.Shutdown(ctx)
+}
+
+// ListDeadLetters returns up to limit jobs from the head of the dead letters
+func (q *Queue) ListDeadLetters(ctx context.Context, limit int) ([]*task.DeadLetter, error) {
+	jobs, err := q.dbClient.QueueJob.
+		Query().
+		Where(queuejob.Queue(q.name), queuejob.DeadAtNotNil()).
+		Order(ent.Asc(queuejob.FieldDeadAt), ent.Asc(queuejob.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deadLetters := make([]*task.DeadLetter, len(jobs))
+	for i, job := range jobs {
+		deadLetters[i] = parseDeadLetter(job)
+	}
+	return deadLetters, nil
+}
+
+func (q *Queue) GetDeadLetter(ctx context.Context, id string) (*task.DeadLetter, error) {
+	jobID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, task.ErrDeadLetterNotFound
+	}
+	job, err := q.dbClient.QueueJob.
+		Query().
+		Where(queuejob.ID(jobID), queuejob.Queue(q.name), queuejob.DeadAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, task.ErrDeadLetterNotFound
+		}
+		return nil, err
+	}
+	return parseDeadLetter(job), nil
+}
+
+// ReplayDeadLetter makes the dead letter visible again with its deliveries reset
+func (q *Queue) ReplayDeadLetter(ctx context.Context, id string) error {
+	jobID, err := strconv.Atoi(id)
+	if err != nil {
+		return task.ErrDeadLetterNotFound
+	}
+	changed, err := q.dbClient.QueueJob.Update().
+		Where(queuejob.ID(jobID), queuejob.Queue(q.name), queuejob.DeadAtNotNil()).
+		ClearDeadAt().
+		ClearDeadReason().
+		SetDeliveries(0).
+		SetVisibleAt(time.Now().UTC()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if changed == 0 {
+		return task.ErrDeadLetterNotFound
+	}
+	q.pool.Wake()
+	return nil
+}
+
+// PurgeDeadLetters deletes all the dead letters, returns the number of deleted jobs
+func (q *Queue) PurgeDeadLetters(ctx context.Context) (int, error) {
+	return q.dbClient.QueueJob.
+		Delete().
+		Where(queuejob.Queue(q.name), queuejob.DeadAtNotNil()).
+		Exec(ctx)
+}
+
+// message is a claimed job, it can be acked or nacked only by the delivery that claimed it
+type message struct {
+	job *ent.QueueJob
+	q   *Queue
+}
+
+func (m *message) Body() []byte {
+	return m.job.Payload
+}
+
+// Ack deletes the job
+func (m *message) Ack() error {
+	n, err := m.q.dbClient.QueueJob.
+		Delete().
+		Where(m.claimed()...).
+		Exec(context.Background())
+	return checkClaimed(n, err)
+}
+
+// Nack makes the job visible again when requeue is true, and dead letters it otherwise
+func (m *message) Nack(requeue bool) error {
+	n := time.Now().UTC()
+	updater := m.q.dbClient.QueueJob.Update().Where(m.claimed()...)
+	if requeue {
+		updater.SetVisibleAt(n)
+	} else {
+		updater.SetDeadAt(n).AddDeadCount(1).SetDeadReason("rejected")
+	}
+	changed, err := updater.Save(context.Background())
+	if err := checkClaimed(changed, err); err != nil {
+		return err
+	}
+	if requeue {
+		m.q.pool.Wake()
+	}
+	return nil
+}
+
+// claimed returns the predicates of the job as long as it was not claimed again after its visibility timeout
+// and not dead lettered
+func (m *message) claimed() []predicate.QueueJob {
+	return []predicate.QueueJob{queuejob.ID(m.job.ID), queuejob.Deliveries(m.job.Deliveries), queuejob.DeadAtIsNil()}
+}
+
+func checkClaimed(n int, err error) error {
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrVisibilityExpired
+	}
+	return nil
+}
+
+func parseDeadLetter(job *ent.QueueJob) *task.DeadLetter {
+	deadLetter := &task.DeadLetter{
+		ID:     strconv.Itoa(job.ID),
+		Body:   string(job.Payload),
+		Reason: job.DeadReason,
+		Count:  job.DeadCount,
+	}
+	if job.DeadAt != nil {
+		deadLetter.DeadAt = *job.DeadAt
+	}
+	return deadLetter
+}
+
+// rollback rolls back a transaction and combine original error with rollback error if occurred
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
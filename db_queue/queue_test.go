@@ -0,0 +1,222 @@
+package db_queue
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/Av1shay/timers-scheduler-demo/ent"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+	"github.com/Av1shay/timers-scheduler-demo/task"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// openQueue opens a queue with a unique name, so tests don't see jobs of other runs
+func openQueue(t *testing.T, opts ...Option) (*Queue, *ent.Client) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := New(dbClient, "test-"+uuid.NewString(), opts...)
+	t.Cleanup(func() {
+		q.Shutdown(ctx)
+		dbClient.QueueJob.Delete().Where(queuejob.Queue(q.name)).Exec(ctx)
+		dbClient.Close()
+	})
+	return q, dbClient
+}
+
+func TestQueue_PublishConsume(t *testing.T) {
+	ctx := context.Background()
+	q, dbClient := openQueue(t, WithWorkers(4))
+
+	tasks := make([]*task.Task, 20)
+	for i := range tasks {
+		tasks[i] = &task.Task{ID: i + 1}
+	}
+	for _, err := range q.PublishBatch(ctx, tasks[:10]) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mu := sync.Mutex{}
+	received := make(map[int]int)
+	done := make(chan struct{}, len(tasks))
+	err := q.Consume(func(m task.Message) {
+		var ta task.Task
+		if err := json.Unmarshal(m.Body(), &ta); err != nil {
+			t.Error(err)
+		}
+		if err := m.Ack(); err != nil {
+			t.Error(err)
+		}
+		mu.Lock()
+		received[ta.ID]++
+		mu.Unlock()
+		done <- struct{}{}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ta := range tasks[10:] {
+		if err := q.Publish(ctx, ta); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := range tasks {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %d messages, got %d", len(tasks), i)
+		}
+	}
+	if err := q.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, ta := range tasks {
+		if received[ta.ID] != 1 {
+			t.Errorf("expected task %d to be received once, got %d", ta.ID, received[ta.ID])
+		}
+	}
+	left, err := dbClient.QueueJob.Query().Where(queuejob.Queue(q.name)).Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if left != 0 {
+		t.Errorf("expected acked jobs to be deleted, got %d jobs", left)
+	}
+}
+
+func TestQueue_VisibilityTimeout(t *testing.T) {
+	ctx := context.Background()
+	q, _ := openQueue(t, WithVisibilityTimeout(100*time.Millisecond), WithMaxDeliveries(2))
+
+	if err := q.Publish(ctx, &task.Task{ID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	jobs, err := q.claim(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].Deliveries != 1 {
+		t.Fatalf("expected to claim 1 job with 1 delivery, got %v", jobs)
+	}
+	first := &message{job: jobs[0], q: q}
+
+	// the job is hidden until its visibility timeout expires
+	jobs, err = q.claim(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 0 {
+		t.Fatalf("expected claimed job to be hidden, got %d jobs", len(jobs))
+	}
+	time.Sleep(150 * time.Millisecond)
+	jobs, err = q.claim(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].Deliveries != 2 {
+		t.Fatalf("expected to claim the job again with 2 deliveries, got %v", jobs)
+	}
+	if err := first.Ack(); err != ErrVisibilityExpired {
+		t.Errorf("expected ack of the first delivery to fail with %v, got %v", ErrVisibilityExpired, err)
+	}
+
+	// after the max deliveries the job is dead lettered
+	time.Sleep(150 * time.Millisecond)
+	jobs, err = q.claim(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 0 {
+		t.Fatalf("expected job to be dead lettered, got %d jobs", len(jobs))
+	}
+	deadLetters, err := q.ListDeadLetters(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deadLetters) != 1 || deadLetters[0].Count != 1 {
+		t.Fatalf("expected 1 dead letter, got %v", deadLetters)
+	}
+
+	// a replayed job is delivered again with its deliveries reset
+	if err := q.ReplayDeadLetter(ctx, deadLetters[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	jobs, err = q.claim(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].Deliveries != 1 {
+		t.Fatalf("expected to claim the replayed job with 1 delivery, got %v", jobs)
+	}
+	if err := (&message{job: jobs[0], q: q}).Ack(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQueue_Nack(t *testing.T) {
+	ctx := context.Background()
+	q, _ := openQueue(t)
+
+	for id := 1; id <= 2; id++ {
+		if err := q.Publish(ctx, &task.Task{ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	jobs, err := q.claim(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("expected to claim 2 jobs, got %d", len(jobs))
+	}
+	if err := (&message{job: jobs[0], q: q}).Nack(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&message{job: jobs[1], q: q}).Nack(false); err != nil {
+		t.Fatal(err)
+	}
+
+	// the requeued job is visible right away, the rejected one is dead lettered
+	requeued, err := q.claim(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requeued) != 1 || requeued[0].ID != jobs[0].ID {
+		t.Fatalf("expected to claim job %d again, got %v", jobs[0].ID, requeued)
+	}
+	deadLetter, err := q.GetDeadLetter(ctx, strconv.Itoa(jobs[1].ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deadLetter.Reason != "rejected" {
+		t.Errorf("expected dead letter reason to be rejected, got %q", deadLetter.Reason)
+	}
+
+	purged, err := q.PurgeDeadLetters(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 {
+		t.Errorf("expected 1 purged job, got %d", purged)
+	}
+	if _, err := q.GetDeadLetter(ctx, strconv.Itoa(jobs[1].ID)); err != task.ErrDeadLetterNotFound {
+		t.Errorf("expected %v, got %v", task.ErrDeadLetterNotFound, err)
+	}
+}
@@ -10,6 +10,9 @@ import (
 
 	"github.com/Av1shay/timers-scheduler-demo/ent/migrate"
 
+	"github.com/Av1shay/timers-scheduler-demo/ent/outboxmessage"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+	"github.com/Av1shay/timers-scheduler-demo/ent/schedulerinstance"
 	"github.com/Av1shay/timers-scheduler-demo/ent/task"
 	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 
@@ -23,6 +26,12 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// OutboxMessage is the client for interacting with the OutboxMessage builders.
+	OutboxMessage *OutboxMessageClient
+	// QueueJob is the client for interacting with the QueueJob builders.
+	QueueJob *QueueJobClient
+	// SchedulerInstance is the client for interacting with the SchedulerInstance builders.
+	SchedulerInstance *SchedulerInstanceClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// TaskHistory is the client for interacting with the TaskHistory builders.
@@ -40,6 +49,9 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.OutboxMessage = NewOutboxMessageClient(c.config)
+	c.QueueJob = NewQueueJobClient(c.config)
+	c.SchedulerInstance = NewSchedulerInstanceClient(c.config)
 	c.Task = NewTaskClient(c.config)
 	c.TaskHistory = NewTaskHistoryClient(c.config)
 }
@@ -73,10 +85,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Task:        NewTaskClient(cfg),
-		TaskHistory: NewTaskHistoryClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		OutboxMessage:     NewOutboxMessageClient(cfg),
+		QueueJob:          NewQueueJobClient(cfg),
+		SchedulerInstance: NewSchedulerInstanceClient(cfg),
+		Task:              NewTaskClient(cfg),
+		TaskHistory:       NewTaskHistoryClient(cfg),
 	}, nil
 }
 
@@ -94,20 +109,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Task:        NewTaskClient(cfg),
-		TaskHistory: NewTaskHistoryClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		OutboxMessage:     NewOutboxMessageClient(cfg),
+		QueueJob:          NewQueueJobClient(cfg),
+		SchedulerInstance: NewSchedulerInstanceClient(cfg),
+		Task:              NewTaskClient(cfg),
+		TaskHistory:       NewTaskHistoryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Task.
+//		OutboxMessage.
 //		Query().
 //		Count(ctx)
-//
 func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
@@ -127,10 +144,283 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.OutboxMessage.Use(hooks...)
+	c.QueueJob.Use(hooks...)
+	c.SchedulerInstance.Use(hooks...)
 	c.Task.Use(hooks...)
 	c.TaskHistory.Use(hooks...)
 }
 
+// OutboxMessageClient is a client for the OutboxMessage schema.
+type OutboxMessageClient struct {
+	config
+}
+
+// NewOutboxMessageClient returns a client for the OutboxMessage from the given config.
+func NewOutboxMessageClient(c config) *OutboxMessageClient {
+	return &OutboxMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxmessage.Hooks(f(g(h())))`.
+func (c *OutboxMessageClient) Use(hooks ...Hook) {
+	c.hooks.OutboxMessage = append(c.hooks.OutboxMessage, hooks...)
+}
+
+// Create returns a builder for creating a OutboxMessage entity.
+func (c *OutboxMessageClient) Create() *OutboxMessageCreate {
+	mutation := newOutboxMessageMutation(c.config, OpCreate)
+	return &OutboxMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxMessage entities.
+func (c *OutboxMessageClient) CreateBulk(builders ...*OutboxMessageCreate) *OutboxMessageCreateBulk {
+	return &OutboxMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxMessage.
+func (c *OutboxMessageClient) Update() *OutboxMessageUpdate {
+	mutation := newOutboxMessageMutation(c.config, OpUpdate)
+	return &OutboxMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxMessageClient) UpdateOne(om *OutboxMessage) *OutboxMessageUpdateOne {
+	mutation := newOutboxMessageMutation(c.config, OpUpdateOne, withOutboxMessage(om))
+	return &OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxMessageClient) UpdateOneID(id int) *OutboxMessageUpdateOne {
+	mutation := newOutboxMessageMutation(c.config, OpUpdateOne, withOutboxMessageID(id))
+	return &OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxMessage.
+func (c *OutboxMessageClient) Delete() *OutboxMessageDelete {
+	mutation := newOutboxMessageMutation(c.config, OpDelete)
+	return &OutboxMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxMessageClient) DeleteOne(om *OutboxMessage) *OutboxMessageDeleteOne {
+	return c.DeleteOneID(om.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxMessageClient) DeleteOneID(id int) *OutboxMessageDeleteOne {
+	builder := c.Delete().Where(outboxmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxMessage.
+func (c *OutboxMessageClient) Query() *OutboxMessageQuery {
+	return &OutboxMessageQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a OutboxMessage entity by its id.
+func (c *OutboxMessageClient) Get(ctx context.Context, id int) (*OutboxMessage, error) {
+	return c.Query().Where(outboxmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxMessageClient) GetX(ctx context.Context, id int) *OutboxMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxMessageClient) Hooks() []Hook {
+	return c.hooks.OutboxMessage
+}
+
+// QueueJobClient is a client for the QueueJob schema.
+type QueueJobClient struct {
+	config
+}
+
+// NewQueueJobClient returns a client for the QueueJob from the given config.
+func NewQueueJobClient(c config) *QueueJobClient {
+	return &QueueJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `queuejob.Hooks(f(g(h())))`.
+func (c *QueueJobClient) Use(hooks ...Hook) {
+	c.hooks.QueueJob = append(c.hooks.QueueJob, hooks...)
+}
+
+// Create returns a builder for creating a QueueJob entity.
+func (c *QueueJobClient) Create() *QueueJobCreate {
+	mutation := newQueueJobMutation(c.config, OpCreate)
+	return &QueueJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QueueJob entities.
+func (c *QueueJobClient) CreateBulk(builders ...*QueueJobCreate) *QueueJobCreateBulk {
+	return &QueueJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QueueJob.
+func (c *QueueJobClient) Update() *QueueJobUpdate {
+	mutation := newQueueJobMutation(c.config, OpUpdate)
+	return &QueueJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QueueJobClient) UpdateOne(qj *QueueJob) *QueueJobUpdateOne {
+	mutation := newQueueJobMutation(c.config, OpUpdateOne, withQueueJob(qj))
+	return &QueueJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QueueJobClient) UpdateOneID(id int) *QueueJobUpdateOne {
+	mutation := newQueueJobMutation(c.config, OpUpdateOne, withQueueJobID(id))
+	return &QueueJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QueueJob.
+func (c *QueueJobClient) Delete() *QueueJobDelete {
+	mutation := newQueueJobMutation(c.config, OpDelete)
+	return &QueueJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QueueJobClient) DeleteOne(qj *QueueJob) *QueueJobDeleteOne {
+	return c.DeleteOneID(qj.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QueueJobClient) DeleteOneID(id int) *QueueJobDeleteOne {
+	builder := c.Delete().Where(queuejob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QueueJobDeleteOne{builder}
+}
+
+// Query returns a query builder for QueueJob.
+func (c *QueueJobClient) Query() *QueueJobQuery {
+	return &QueueJobQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a QueueJob entity by its id.
+func (c *QueueJobClient) Get(ctx context.Context, id int) (*QueueJob, error) {
+	return c.Query().Where(queuejob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QueueJobClient) GetX(ctx context.Context, id int) *QueueJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QueueJobClient) Hooks() []Hook {
+	return c.hooks.QueueJob
+}
+
+// SchedulerInstanceClient is a client for the SchedulerInstance schema.
+type SchedulerInstanceClient struct {
+	config
+}
+
+// NewSchedulerInstanceClient returns a client for the SchedulerInstance from the given config.
+func NewSchedulerInstanceClient(c config) *SchedulerInstanceClient {
+	return &SchedulerInstanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `schedulerinstance.Hooks(f(g(h())))`.
+func (c *SchedulerInstanceClient) Use(hooks ...Hook) {
+	c.hooks.SchedulerInstance = append(c.hooks.SchedulerInstance, hooks...)
+}
+
+// Create returns a builder for creating a SchedulerInstance entity.
+func (c *SchedulerInstanceClient) Create() *SchedulerInstanceCreate {
+	mutation := newSchedulerInstanceMutation(c.config, OpCreate)
+	return &SchedulerInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SchedulerInstance entities.
+func (c *SchedulerInstanceClient) CreateBulk(builders ...*SchedulerInstanceCreate) *SchedulerInstanceCreateBulk {
+	return &SchedulerInstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SchedulerInstance.
+func (c *SchedulerInstanceClient) Update() *SchedulerInstanceUpdate {
+	mutation := newSchedulerInstanceMutation(c.config, OpUpdate)
+	return &SchedulerInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SchedulerInstanceClient) UpdateOne(si *SchedulerInstance) *SchedulerInstanceUpdateOne {
+	mutation := newSchedulerInstanceMutation(c.config, OpUpdateOne, withSchedulerInstance(si))
+	return &SchedulerInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SchedulerInstanceClient) UpdateOneID(id string) *SchedulerInstanceUpdateOne {
+	mutation := newSchedulerInstanceMutation(c.config, OpUpdateOne, withSchedulerInstanceID(id))
+	return &SchedulerInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SchedulerInstance.
+func (c *SchedulerInstanceClient) Delete() *SchedulerInstanceDelete {
+	mutation := newSchedulerInstanceMutation(c.config, OpDelete)
+	return &SchedulerInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SchedulerInstanceClient) DeleteOne(si *SchedulerInstance) *SchedulerInstanceDeleteOne {
+	return c.DeleteOneID(si.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SchedulerInstanceClient) DeleteOneID(id string) *SchedulerInstanceDeleteOne {
+	builder := c.Delete().Where(schedulerinstance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SchedulerInstanceDeleteOne{builder}
+}
+
+// Query returns a query builder for SchedulerInstance.
+func (c *SchedulerInstanceClient) Query() *SchedulerInstanceQuery {
+	return &SchedulerInstanceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a SchedulerInstance entity by its id.
+func (c *SchedulerInstanceClient) Get(ctx context.Context, id string) (*SchedulerInstance, error) {
+	return c.Query().Where(schedulerinstance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SchedulerInstanceClient) GetX(ctx context.Context, id string) *SchedulerInstance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SchedulerInstanceClient) Hooks() []Hook {
+	return c.hooks.SchedulerInstance
+}
+
 // TaskClient is a client for the Task schema.
 type TaskClient struct {
 	config
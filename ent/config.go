@@ -24,8 +24,11 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Task        []ent.Hook
-	TaskHistory []ent.Hook
+	OutboxMessage     []ent.Hook
+	QueueJob          []ent.Hook
+	SchedulerInstance []ent.Hook
+	Task              []ent.Hook
+	TaskHistory       []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -6,12 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/Av1shay/timers-scheduler-demo/ent/task"
-	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Av1shay/timers-scheduler-demo/ent/outboxmessage"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+	"github.com/Av1shay/timers-scheduler-demo/ent/schedulerinstance"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -32,8 +35,11 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		task.Table:        task.ValidColumn,
-		taskhistory.Table: taskhistory.ValidColumn,
+		outboxmessage.Table:     outboxmessage.ValidColumn,
+		queuejob.Table:          queuejob.ValidColumn,
+		schedulerinstance.Table: schedulerinstance.ValidColumn,
+		task.Table:              task.ValidColumn,
+		taskhistory.Table:       taskhistory.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -83,7 +89,6 @@ type AggregateFunc func(*sql.Selector) string
 //	GroupBy(field1, field2).
 //	Aggregate(ent.As(ent.Sum(field1), "sum_field1"), (ent.As(ent.Sum(field2), "sum_field2")).
 //	Scan(ctx, &v)
-//
 func As(fn AggregateFunc, end string) AggregateFunc {
 	return func(s *sql.Selector) string {
 		return sql.As(fn(s), end)
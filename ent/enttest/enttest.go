@@ -4,13 +4,13 @@ package enttest
 
 import (
 	"context"
+
 	"github.com/Av1shay/timers-scheduler-demo/ent"
 	// required by schema hooks.
 	_ "github.com/Av1shay/timers-scheduler-demo/ent/runtime"
 
-	"github.com/Av1shay/timers-scheduler-demo/ent/migrate"
-
 	"entgo.io/ent/dialect/sql/schema"
+	"github.com/Av1shay/timers-scheduler-demo/ent/migrate"
 )
 
 type (
@@ -8,14 +8,105 @@ import (
 )
 
 var (
+	// OutboxMessagesColumns holds the columns for the "outbox_messages" table.
+	OutboxMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "task_id", Type: field.TypeInt},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "relay_until", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxMessagesTable holds the schema information for the "outbox_messages" table.
+	OutboxMessagesTable = &schema.Table{
+		Name:       "outbox_messages",
+		Columns:    OutboxMessagesColumns,
+		PrimaryKey: []*schema.Column{OutboxMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxmessage_sent_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxMessagesColumns[3]},
+			},
+			{
+				Name:    "outboxmessage_task_id_sent_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxMessagesColumns[1], OutboxMessagesColumns[3]},
+			},
+		},
+	}
+	// QueueJobsColumns holds the columns for the "queue_jobs" table.
+	QueueJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "queue", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "visible_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "timestamp(3)"}},
+		{Name: "deliveries", Type: field.TypeInt, Default: 0},
+		{Name: "dead_at", Type: field.TypeTime, Nullable: true},
+		{Name: "dead_count", Type: field.TypeInt, Default: 0},
+		{Name: "dead_reason", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// QueueJobsTable holds the schema information for the "queue_jobs" table.
+	QueueJobsTable = &schema.Table{
+		Name:       "queue_jobs",
+		Columns:    QueueJobsColumns,
+		PrimaryKey: []*schema.Column{QueueJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "queuejob_queue_dead_at_visible_at",
+				Unique:  false,
+				Columns: []*schema.Column{QueueJobsColumns[1], QueueJobsColumns[5], QueueJobsColumns[3]},
+			},
+		},
+	}
+	// SchedulerInstancesColumns holds the columns for the "scheduler_instances" table.
+	SchedulerInstancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "heartbeat_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SchedulerInstancesTable holds the schema information for the "scheduler_instances" table.
+	SchedulerInstancesTable = &schema.Table{
+		Name:       "scheduler_instances",
+		Columns:    SchedulerInstancesColumns,
+		PrimaryKey: []*schema.Column{SchedulerInstancesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedulerinstance_heartbeat_at",
+				Unique:  false,
+				Columns: []*schema.Column{SchedulerInstancesColumns[1]},
+			},
+		},
+	}
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "due_date", Type: field.TypeTime},
+		{Name: "due_date", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "timestamp(3)"}},
 		{Name: "webhook_url", Type: field.TypeString},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "done"}, Default: "pending"},
+		{Name: "webhook_host", Type: field.TypeString, Default: ""},
+		{Name: "method", Type: field.TypeString, Default: "POST"},
+		{Name: "headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "omit_id_suffix", Type: field.TypeBool, Default: false},
+		{Name: "cron", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "delivering", "done", "failed", "cancelled"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "delivery_id", Type: field.TypeString, Nullable: true},
+		{Name: "lease_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reclaims", Type: field.TypeInt, Default: 0},
+		{Name: "shard", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Nullable: true},
+		{Name: "retry_base_delay_ms", Type: field.TypeInt64, Nullable: true},
+		{Name: "retry_max_delay_ms", Type: field.TypeInt64, Nullable: true},
+		{Name: "retry_jitter", Type: field.TypeFloat64, Nullable: true},
+		{Name: "caller", Type: field.TypeString, Default: ""},
+		{Name: "idempotency_key", Type: field.TypeString, Nullable: true},
+		{Name: "request_hash", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
-		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "timestamp(6)"}},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
 	TasksTable = &schema.Table{
@@ -31,19 +122,49 @@ var (
 			{
 				Name:    "task_status",
 				Unique:  false,
-				Columns: []*schema.Column{TasksColumns[3]},
+				Columns: []*schema.Column{TasksColumns[9]},
 			},
 			{
 				Name:    "task_due_date_status",
 				Unique:  false,
-				Columns: []*schema.Column{TasksColumns[1], TasksColumns[3]},
+				Columns: []*schema.Column{TasksColumns[1], TasksColumns[9]},
+			},
+			{
+				Name:    "task_status_lease_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[9], TasksColumns[12]},
+			},
+			{
+				Name:    "task_shard_status_due_date",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[14], TasksColumns[9], TasksColumns[1]},
+			},
+			{
+				Name:    "task_webhook_host",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[3]},
+			},
+			{
+				Name:    "task_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[22]},
+			},
+			{
+				Name:    "task_caller_idempotency_key",
+				Unique:  true,
+				Columns: []*schema.Column{TasksColumns[19], TasksColumns[20]},
 			},
 		},
 	}
 	// TaskHistoriesColumns holds the columns for the "task_histories" table.
 	TaskHistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event", Type: field.TypeEnum, Enums: []string{"run", "reclaim"}, Default: "run"},
 		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "attempt", Type: field.TypeInt, Default: 1},
+		{Name: "http_status", Type: field.TypeInt, Nullable: true},
+		{Name: "latency_ms", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "task_histories", Type: field.TypeInt, Nullable: true},
@@ -56,7 +177,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "task_histories_tasks_histories",
-				Columns:    []*schema.Column{TaskHistoriesColumns[4]},
+				Columns:    []*schema.Column{TaskHistoriesColumns[9]},
 				RefColumns: []*schema.Column{TasksColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -64,6 +185,9 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		OutboxMessagesTable,
+		QueueJobsTable,
+		SchedulerInstancesTable,
 		TasksTable,
 		TaskHistoriesTable,
 	}
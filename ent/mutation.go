@@ -7,10 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
+
+	"github.com/Av1shay/timers-scheduler-demo/ent/outboxmessage"
 	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+	"github.com/Av1shay/timers-scheduler-demo/ent/schedulerinstance"
 	"github.com/Av1shay/timers-scheduler-demo/ent/task"
 	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
-	"time"
 
 	"entgo.io/ent"
 )
@@ -24,41 +28,45 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeTask        = "Task"
-	TypeTaskHistory = "TaskHistory"
+	TypeOutboxMessage     = "OutboxMessage"
+	TypeQueueJob          = "QueueJob"
+	TypeSchedulerInstance = "SchedulerInstance"
+	TypeTask              = "Task"
+	TypeTaskHistory       = "TaskHistory"
 )
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
+// OutboxMessageMutation represents an operation that mutates the OutboxMessage nodes in the graph.
+type OutboxMessageMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	dueDate          *time.Time
-	webhookUrl       *string
-	status           *task.Status
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	histories        map[int]struct{}
-	removedhistories map[int]struct{}
-	clearedhistories bool
-	done             bool
-	oldValue         func(context.Context) (*Task, error)
-	predicates       []predicate.Task
+	op            Op
+	typ           string
+	id            *int
+	taskId        *int
+	addtaskId     *int
+	payload       *[]byte
+	sentAt        *time.Time
+	relayUntil    *time.Time
+	attempts      *int
+	addattempts   *int
+	lastError     *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*OutboxMessage, error)
+	predicates    []predicate.OutboxMessage
 }
 
-var _ ent.Mutation = (*TaskMutation)(nil)
+var _ ent.Mutation = (*OutboxMessageMutation)(nil)
 
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
+// outboxmessageOption allows management of the mutation configuration using functional options.
+type outboxmessageOption func(*OutboxMessageMutation)
 
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
+// newOutboxMessageMutation creates new mutation for the OutboxMessage entity.
+func newOutboxMessageMutation(c config, op Op, opts ...outboxmessageOption) *OutboxMessageMutation {
+	m := &OutboxMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTask,
+		typ:           TypeOutboxMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -67,191 +75,3084 @@ func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
 	return m
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id int) taskOption {
-	return func(m *TaskMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Task
-		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Task.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// withOutboxMessageID sets the ID field of the mutation.
+func withOutboxMessageID(id int) outboxmessageOption {
+	return func(m *OutboxMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxMessage
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxMessage sets the old OutboxMessage of the mutation.
+func withOutboxMessage(node *OutboxMessage) outboxmessageOption {
+	return func(m *OutboxMessageMutation) {
+		m.oldValue = func(context.Context) (*OutboxMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxMessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxMessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskId sets the "taskId" field.
+func (m *OutboxMessageMutation) SetTaskId(i int) {
+	m.taskId = &i
+	m.addtaskId = nil
+}
+
+// TaskId returns the value of the "taskId" field in the mutation.
+func (m *OutboxMessageMutation) TaskId() (r int, exists bool) {
+	v := m.taskId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskId returns the old "taskId" field's value of the OutboxMessage entity.
+// If the OutboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMessageMutation) OldTaskId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskId: %w", err)
+	}
+	return oldValue.TaskId, nil
+}
+
+// AddTaskId adds i to the "taskId" field.
+func (m *OutboxMessageMutation) AddTaskId(i int) {
+	if m.addtaskId != nil {
+		*m.addtaskId += i
+	} else {
+		m.addtaskId = &i
+	}
+}
+
+// AddedTaskId returns the value that was added to the "taskId" field in this mutation.
+func (m *OutboxMessageMutation) AddedTaskId() (r int, exists bool) {
+	v := m.addtaskId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTaskId resets all changes to the "taskId" field.
+func (m *OutboxMessageMutation) ResetTaskId() {
+	m.taskId = nil
+	m.addtaskId = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxMessageMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxMessageMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxMessage entity.
+// If the OutboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMessageMutation) OldPayload(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxMessageMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetSentAt sets the "sentAt" field.
+func (m *OutboxMessageMutation) SetSentAt(t time.Time) {
+	m.sentAt = &t
+}
+
+// SentAt returns the value of the "sentAt" field in the mutation.
+func (m *OutboxMessageMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sentAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sentAt" field's value of the OutboxMessage entity.
+// If the OutboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMessageMutation) OldSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ClearSentAt clears the value of the "sentAt" field.
+func (m *OutboxMessageMutation) ClearSentAt() {
+	m.sentAt = nil
+	m.clearedFields[outboxmessage.FieldSentAt] = struct{}{}
+}
+
+// SentAtCleared returns if the "sentAt" field was cleared in this mutation.
+func (m *OutboxMessageMutation) SentAtCleared() bool {
+	_, ok := m.clearedFields[outboxmessage.FieldSentAt]
+	return ok
+}
+
+// ResetSentAt resets all changes to the "sentAt" field.
+func (m *OutboxMessageMutation) ResetSentAt() {
+	m.sentAt = nil
+	delete(m.clearedFields, outboxmessage.FieldSentAt)
+}
+
+// SetRelayUntil sets the "relayUntil" field.
+func (m *OutboxMessageMutation) SetRelayUntil(t time.Time) {
+	m.relayUntil = &t
+}
+
+// RelayUntil returns the value of the "relayUntil" field in the mutation.
+func (m *OutboxMessageMutation) RelayUntil() (r time.Time, exists bool) {
+	v := m.relayUntil
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRelayUntil returns the old "relayUntil" field's value of the OutboxMessage entity.
+// If the OutboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMessageMutation) OldRelayUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRelayUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRelayUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRelayUntil: %w", err)
+	}
+	return oldValue.RelayUntil, nil
+}
+
+// ClearRelayUntil clears the value of the "relayUntil" field.
+func (m *OutboxMessageMutation) ClearRelayUntil() {
+	m.relayUntil = nil
+	m.clearedFields[outboxmessage.FieldRelayUntil] = struct{}{}
+}
+
+// RelayUntilCleared returns if the "relayUntil" field was cleared in this mutation.
+func (m *OutboxMessageMutation) RelayUntilCleared() bool {
+	_, ok := m.clearedFields[outboxmessage.FieldRelayUntil]
+	return ok
+}
+
+// ResetRelayUntil resets all changes to the "relayUntil" field.
+func (m *OutboxMessageMutation) ResetRelayUntil() {
+	m.relayUntil = nil
+	delete(m.clearedFields, outboxmessage.FieldRelayUntil)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxMessageMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxMessageMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxMessage entity.
+// If the OutboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMessageMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxMessageMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxMessageMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxMessageMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "lastError" field.
+func (m *OutboxMessageMutation) SetLastError(s string) {
+	m.lastError = &s
+}
+
+// LastError returns the value of the "lastError" field in the mutation.
+func (m *OutboxMessageMutation) LastError() (r string, exists bool) {
+	v := m.lastError
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "lastError" field's value of the OutboxMessage entity.
+// If the OutboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMessageMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (m *OutboxMessageMutation) ClearLastError() {
+	m.lastError = nil
+	m.clearedFields[outboxmessage.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "lastError" field was cleared in this mutation.
+func (m *OutboxMessageMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[outboxmessage.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "lastError" field.
+func (m *OutboxMessageMutation) ResetLastError() {
+	m.lastError = nil
+	delete(m.clearedFields, outboxmessage.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxMessage entity.
+// If the OutboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the OutboxMessageMutation builder.
+func (m *OutboxMessageMutation) Where(ps ...predicate.OutboxMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *OutboxMessageMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (OutboxMessage).
+func (m *OutboxMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxMessageMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.taskId != nil {
+		fields = append(fields, outboxmessage.FieldTaskId)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxmessage.FieldPayload)
+	}
+	if m.sentAt != nil {
+		fields = append(fields, outboxmessage.FieldSentAt)
+	}
+	if m.relayUntil != nil {
+		fields = append(fields, outboxmessage.FieldRelayUntil)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxmessage.FieldAttempts)
+	}
+	if m.lastError != nil {
+		fields = append(fields, outboxmessage.FieldLastError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, outboxmessage.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxmessage.FieldTaskId:
+		return m.TaskId()
+	case outboxmessage.FieldPayload:
+		return m.Payload()
+	case outboxmessage.FieldSentAt:
+		return m.SentAt()
+	case outboxmessage.FieldRelayUntil:
+		return m.RelayUntil()
+	case outboxmessage.FieldAttempts:
+		return m.Attempts()
+	case outboxmessage.FieldLastError:
+		return m.LastError()
+	case outboxmessage.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxmessage.FieldTaskId:
+		return m.OldTaskId(ctx)
+	case outboxmessage.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxmessage.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case outboxmessage.FieldRelayUntil:
+		return m.OldRelayUntil(ctx)
+	case outboxmessage.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxmessage.FieldLastError:
+		return m.OldLastError(ctx)
+	case outboxmessage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxmessage.FieldTaskId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskId(v)
+		return nil
+	case outboxmessage.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxmessage.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case outboxmessage.FieldRelayUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRelayUntil(v)
+		return nil
+	case outboxmessage.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxmessage.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case outboxmessage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addtaskId != nil {
+		fields = append(fields, outboxmessage.FieldTaskId)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, outboxmessage.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxmessage.FieldTaskId:
+		return m.AddedTaskId()
+	case outboxmessage.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxmessage.FieldTaskId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskId(v)
+		return nil
+	case outboxmessage.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outboxmessage.FieldSentAt) {
+		fields = append(fields, outboxmessage.FieldSentAt)
+	}
+	if m.FieldCleared(outboxmessage.FieldRelayUntil) {
+		fields = append(fields, outboxmessage.FieldRelayUntil)
+	}
+	if m.FieldCleared(outboxmessage.FieldLastError) {
+		fields = append(fields, outboxmessage.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxMessageMutation) ClearField(name string) error {
+	switch name {
+	case outboxmessage.FieldSentAt:
+		m.ClearSentAt()
+		return nil
+	case outboxmessage.FieldRelayUntil:
+		m.ClearRelayUntil()
+		return nil
+	case outboxmessage.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxMessageMutation) ResetField(name string) error {
+	switch name {
+	case outboxmessage.FieldTaskId:
+		m.ResetTaskId()
+		return nil
+	case outboxmessage.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxmessage.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case outboxmessage.FieldRelayUntil:
+		m.ResetRelayUntil()
+		return nil
+	case outboxmessage.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxmessage.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case outboxmessage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxMessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxMessage edge %s", name)
+}
+
+// QueueJobMutation represents an operation that mutates the QueueJob nodes in the graph.
+type QueueJobMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	queue         *string
+	payload       *[]byte
+	visibleAt     *time.Time
+	deliveries    *int
+	adddeliveries *int
+	deadAt        *time.Time
+	deadCount     *int
+	adddeadCount  *int
+	deadReason    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*QueueJob, error)
+	predicates    []predicate.QueueJob
+}
+
+var _ ent.Mutation = (*QueueJobMutation)(nil)
+
+// queuejobOption allows management of the mutation configuration using functional options.
+type queuejobOption func(*QueueJobMutation)
+
+// newQueueJobMutation creates new mutation for the QueueJob entity.
+func newQueueJobMutation(c config, op Op, opts ...queuejobOption) *QueueJobMutation {
+	m := &QueueJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQueueJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQueueJobID sets the ID field of the mutation.
+func withQueueJobID(id int) queuejobOption {
+	return func(m *QueueJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QueueJob
+		)
+		m.oldValue = func(ctx context.Context) (*QueueJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QueueJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQueueJob sets the old QueueJob of the mutation.
+func withQueueJob(node *QueueJob) queuejobOption {
+	return func(m *QueueJobMutation) {
+		m.oldValue = func(context.Context) (*QueueJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QueueJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QueueJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QueueJobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QueueJobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QueueJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQueue sets the "queue" field.
+func (m *QueueJobMutation) SetQueue(s string) {
+	m.queue = &s
+}
+
+// Queue returns the value of the "queue" field in the mutation.
+func (m *QueueJobMutation) Queue() (r string, exists bool) {
+	v := m.queue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueue returns the old "queue" field's value of the QueueJob entity.
+// If the QueueJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueueJobMutation) OldQueue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueue: %w", err)
+	}
+	return oldValue.Queue, nil
+}
+
+// ResetQueue resets all changes to the "queue" field.
+func (m *QueueJobMutation) ResetQueue() {
+	m.queue = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *QueueJobMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *QueueJobMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the QueueJob entity.
+// If the QueueJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueueJobMutation) OldPayload(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *QueueJobMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetVisibleAt sets the "visibleAt" field.
+func (m *QueueJobMutation) SetVisibleAt(t time.Time) {
+	m.visibleAt = &t
+}
+
+// VisibleAt returns the value of the "visibleAt" field in the mutation.
+func (m *QueueJobMutation) VisibleAt() (r time.Time, exists bool) {
+	v := m.visibleAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibleAt returns the old "visibleAt" field's value of the QueueJob entity.
+// If the QueueJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueueJobMutation) OldVisibleAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibleAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibleAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibleAt: %w", err)
+	}
+	return oldValue.VisibleAt, nil
+}
+
+// ResetVisibleAt resets all changes to the "visibleAt" field.
+func (m *QueueJobMutation) ResetVisibleAt() {
+	m.visibleAt = nil
+}
+
+// SetDeliveries sets the "deliveries" field.
+func (m *QueueJobMutation) SetDeliveries(i int) {
+	m.deliveries = &i
+	m.adddeliveries = nil
+}
+
+// Deliveries returns the value of the "deliveries" field in the mutation.
+func (m *QueueJobMutation) Deliveries() (r int, exists bool) {
+	v := m.deliveries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveries returns the old "deliveries" field's value of the QueueJob entity.
+// If the QueueJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueueJobMutation) OldDeliveries(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveries: %w", err)
+	}
+	return oldValue.Deliveries, nil
+}
+
+// AddDeliveries adds i to the "deliveries" field.
+func (m *QueueJobMutation) AddDeliveries(i int) {
+	if m.adddeliveries != nil {
+		*m.adddeliveries += i
+	} else {
+		m.adddeliveries = &i
+	}
+}
+
+// AddedDeliveries returns the value that was added to the "deliveries" field in this mutation.
+func (m *QueueJobMutation) AddedDeliveries() (r int, exists bool) {
+	v := m.adddeliveries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeliveries resets all changes to the "deliveries" field.
+func (m *QueueJobMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.adddeliveries = nil
+}
+
+// SetDeadAt sets the "deadAt" field.
+func (m *QueueJobMutation) SetDeadAt(t time.Time) {
+	m.deadAt = &t
+}
+
+// DeadAt returns the value of the "deadAt" field in the mutation.
+func (m *QueueJobMutation) DeadAt() (r time.Time, exists bool) {
+	v := m.deadAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadAt returns the old "deadAt" field's value of the QueueJob entity.
+// If the QueueJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueueJobMutation) OldDeadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadAt: %w", err)
+	}
+	return oldValue.DeadAt, nil
+}
+
+// ClearDeadAt clears the value of the "deadAt" field.
+func (m *QueueJobMutation) ClearDeadAt() {
+	m.deadAt = nil
+	m.clearedFields[queuejob.FieldDeadAt] = struct{}{}
+}
+
+// DeadAtCleared returns if the "deadAt" field was cleared in this mutation.
+func (m *QueueJobMutation) DeadAtCleared() bool {
+	_, ok := m.clearedFields[queuejob.FieldDeadAt]
+	return ok
+}
+
+// ResetDeadAt resets all changes to the "deadAt" field.
+func (m *QueueJobMutation) ResetDeadAt() {
+	m.deadAt = nil
+	delete(m.clearedFields, queuejob.FieldDeadAt)
+}
+
+// SetDeadCount sets the "deadCount" field.
+func (m *QueueJobMutation) SetDeadCount(i int) {
+	m.deadCount = &i
+	m.adddeadCount = nil
+}
+
+// DeadCount returns the value of the "deadCount" field in the mutation.
+func (m *QueueJobMutation) DeadCount() (r int, exists bool) {
+	v := m.deadCount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadCount returns the old "deadCount" field's value of the QueueJob entity.
+// If the QueueJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueueJobMutation) OldDeadCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadCount: %w", err)
+	}
+	return oldValue.DeadCount, nil
+}
+
+// AddDeadCount adds i to the "deadCount" field.
+func (m *QueueJobMutation) AddDeadCount(i int) {
+	if m.adddeadCount != nil {
+		*m.adddeadCount += i
+	} else {
+		m.adddeadCount = &i
+	}
+}
+
+// AddedDeadCount returns the value that was added to the "deadCount" field in this mutation.
+func (m *QueueJobMutation) AddedDeadCount() (r int, exists bool) {
+	v := m.adddeadCount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeadCount resets all changes to the "deadCount" field.
+func (m *QueueJobMutation) ResetDeadCount() {
+	m.deadCount = nil
+	m.adddeadCount = nil
+}
+
+// SetDeadReason sets the "deadReason" field.
+func (m *QueueJobMutation) SetDeadReason(s string) {
+	m.deadReason = &s
+}
+
+// DeadReason returns the value of the "deadReason" field in the mutation.
+func (m *QueueJobMutation) DeadReason() (r string, exists bool) {
+	v := m.deadReason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadReason returns the old "deadReason" field's value of the QueueJob entity.
+// If the QueueJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueueJobMutation) OldDeadReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadReason: %w", err)
+	}
+	return oldValue.DeadReason, nil
+}
+
+// ClearDeadReason clears the value of the "deadReason" field.
+func (m *QueueJobMutation) ClearDeadReason() {
+	m.deadReason = nil
+	m.clearedFields[queuejob.FieldDeadReason] = struct{}{}
+}
+
+// DeadReasonCleared returns if the "deadReason" field was cleared in this mutation.
+func (m *QueueJobMutation) DeadReasonCleared() bool {
+	_, ok := m.clearedFields[queuejob.FieldDeadReason]
+	return ok
+}
+
+// ResetDeadReason resets all changes to the "deadReason" field.
+func (m *QueueJobMutation) ResetDeadReason() {
+	m.deadReason = nil
+	delete(m.clearedFields, queuejob.FieldDeadReason)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QueueJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QueueJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the QueueJob entity.
+// If the QueueJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueueJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QueueJobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the QueueJobMutation builder.
+func (m *QueueJobMutation) Where(ps ...predicate.QueueJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *QueueJobMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (QueueJob).
+func (m *QueueJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QueueJobMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.queue != nil {
+		fields = append(fields, queuejob.FieldQueue)
+	}
+	if m.payload != nil {
+		fields = append(fields, queuejob.FieldPayload)
+	}
+	if m.visibleAt != nil {
+		fields = append(fields, queuejob.FieldVisibleAt)
+	}
+	if m.deliveries != nil {
+		fields = append(fields, queuejob.FieldDeliveries)
+	}
+	if m.deadAt != nil {
+		fields = append(fields, queuejob.FieldDeadAt)
+	}
+	if m.deadCount != nil {
+		fields = append(fields, queuejob.FieldDeadCount)
+	}
+	if m.deadReason != nil {
+		fields = append(fields, queuejob.FieldDeadReason)
+	}
+	if m.created_at != nil {
+		fields = append(fields, queuejob.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QueueJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case queuejob.FieldQueue:
+		return m.Queue()
+	case queuejob.FieldPayload:
+		return m.Payload()
+	case queuejob.FieldVisibleAt:
+		return m.VisibleAt()
+	case queuejob.FieldDeliveries:
+		return m.Deliveries()
+	case queuejob.FieldDeadAt:
+		return m.DeadAt()
+	case queuejob.FieldDeadCount:
+		return m.DeadCount()
+	case queuejob.FieldDeadReason:
+		return m.DeadReason()
+	case queuejob.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QueueJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case queuejob.FieldQueue:
+		return m.OldQueue(ctx)
+	case queuejob.FieldPayload:
+		return m.OldPayload(ctx)
+	case queuejob.FieldVisibleAt:
+		return m.OldVisibleAt(ctx)
+	case queuejob.FieldDeliveries:
+		return m.OldDeliveries(ctx)
+	case queuejob.FieldDeadAt:
+		return m.OldDeadAt(ctx)
+	case queuejob.FieldDeadCount:
+		return m.OldDeadCount(ctx)
+	case queuejob.FieldDeadReason:
+		return m.OldDeadReason(ctx)
+	case queuejob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown QueueJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QueueJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case queuejob.FieldQueue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueue(v)
+		return nil
+	case queuejob.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case queuejob.FieldVisibleAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibleAt(v)
+		return nil
+	case queuejob.FieldDeliveries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveries(v)
+		return nil
+	case queuejob.FieldDeadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadAt(v)
+		return nil
+	case queuejob.FieldDeadCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadCount(v)
+		return nil
+	case queuejob.FieldDeadReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadReason(v)
+		return nil
+	case queuejob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QueueJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QueueJobMutation) AddedFields() []string {
+	var fields []string
+	if m.adddeliveries != nil {
+		fields = append(fields, queuejob.FieldDeliveries)
+	}
+	if m.adddeadCount != nil {
+		fields = append(fields, queuejob.FieldDeadCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QueueJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case queuejob.FieldDeliveries:
+		return m.AddedDeliveries()
+	case queuejob.FieldDeadCount:
+		return m.AddedDeadCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QueueJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case queuejob.FieldDeliveries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeliveries(v)
+		return nil
+	case queuejob.FieldDeadCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeadCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QueueJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QueueJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(queuejob.FieldDeadAt) {
+		fields = append(fields, queuejob.FieldDeadAt)
+	}
+	if m.FieldCleared(queuejob.FieldDeadReason) {
+		fields = append(fields, queuejob.FieldDeadReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QueueJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QueueJobMutation) ClearField(name string) error {
+	switch name {
+	case queuejob.FieldDeadAt:
+		m.ClearDeadAt()
+		return nil
+	case queuejob.FieldDeadReason:
+		m.ClearDeadReason()
+		return nil
+	}
+	return fmt.Errorf("unknown QueueJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QueueJobMutation) ResetField(name string) error {
+	switch name {
+	case queuejob.FieldQueue:
+		m.ResetQueue()
+		return nil
+	case queuejob.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case queuejob.FieldVisibleAt:
+		m.ResetVisibleAt()
+		return nil
+	case queuejob.FieldDeliveries:
+		m.ResetDeliveries()
+		return nil
+	case queuejob.FieldDeadAt:
+		m.ResetDeadAt()
+		return nil
+	case queuejob.FieldDeadCount:
+		m.ResetDeadCount()
+		return nil
+	case queuejob.FieldDeadReason:
+		m.ResetDeadReason()
+		return nil
+	case queuejob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown QueueJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QueueJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QueueJobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QueueJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QueueJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QueueJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QueueJobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QueueJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown QueueJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QueueJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown QueueJob edge %s", name)
+}
+
+// SchedulerInstanceMutation represents an operation that mutates the SchedulerInstance nodes in the graph.
+type SchedulerInstanceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	heartbeatAt   *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SchedulerInstance, error)
+	predicates    []predicate.SchedulerInstance
+}
+
+var _ ent.Mutation = (*SchedulerInstanceMutation)(nil)
+
+// schedulerinstanceOption allows management of the mutation configuration using functional options.
+type schedulerinstanceOption func(*SchedulerInstanceMutation)
+
+// newSchedulerInstanceMutation creates new mutation for the SchedulerInstance entity.
+func newSchedulerInstanceMutation(c config, op Op, opts ...schedulerinstanceOption) *SchedulerInstanceMutation {
+	m := &SchedulerInstanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSchedulerInstance,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSchedulerInstanceID sets the ID field of the mutation.
+func withSchedulerInstanceID(id string) schedulerinstanceOption {
+	return func(m *SchedulerInstanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SchedulerInstance
+		)
+		m.oldValue = func(ctx context.Context) (*SchedulerInstance, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SchedulerInstance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSchedulerInstance sets the old SchedulerInstance of the mutation.
+func withSchedulerInstance(node *SchedulerInstance) schedulerinstanceOption {
+	return func(m *SchedulerInstanceMutation) {
+		m.oldValue = func(context.Context) (*SchedulerInstance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SchedulerInstanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SchedulerInstanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SchedulerInstance entities.
+func (m *SchedulerInstanceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SchedulerInstanceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SchedulerInstanceMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SchedulerInstance.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHeartbeatAt sets the "heartbeatAt" field.
+func (m *SchedulerInstanceMutation) SetHeartbeatAt(t time.Time) {
+	m.heartbeatAt = &t
+}
+
+// HeartbeatAt returns the value of the "heartbeatAt" field in the mutation.
+func (m *SchedulerInstanceMutation) HeartbeatAt() (r time.Time, exists bool) {
+	v := m.heartbeatAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeartbeatAt returns the old "heartbeatAt" field's value of the SchedulerInstance entity.
+// If the SchedulerInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerInstanceMutation) OldHeartbeatAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeartbeatAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeartbeatAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeartbeatAt: %w", err)
+	}
+	return oldValue.HeartbeatAt, nil
+}
+
+// ResetHeartbeatAt resets all changes to the "heartbeatAt" field.
+func (m *SchedulerInstanceMutation) ResetHeartbeatAt() {
+	m.heartbeatAt = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SchedulerInstanceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SchedulerInstanceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SchedulerInstance entity.
+// If the SchedulerInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerInstanceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SchedulerInstanceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the SchedulerInstanceMutation builder.
+func (m *SchedulerInstanceMutation) Where(ps ...predicate.SchedulerInstance) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *SchedulerInstanceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (SchedulerInstance).
+func (m *SchedulerInstanceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SchedulerInstanceMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.heartbeatAt != nil {
+		fields = append(fields, schedulerinstance.FieldHeartbeatAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, schedulerinstance.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SchedulerInstanceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schedulerinstance.FieldHeartbeatAt:
+		return m.HeartbeatAt()
+	case schedulerinstance.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SchedulerInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schedulerinstance.FieldHeartbeatAt:
+		return m.OldHeartbeatAt(ctx)
+	case schedulerinstance.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SchedulerInstance field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchedulerInstanceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schedulerinstance.FieldHeartbeatAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeartbeatAt(v)
+		return nil
+	case schedulerinstance.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SchedulerInstance field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SchedulerInstanceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SchedulerInstanceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchedulerInstanceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SchedulerInstance numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SchedulerInstanceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SchedulerInstanceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SchedulerInstanceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SchedulerInstance nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SchedulerInstanceMutation) ResetField(name string) error {
+	switch name {
+	case schedulerinstance.FieldHeartbeatAt:
+		m.ResetHeartbeatAt()
+		return nil
+	case schedulerinstance.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SchedulerInstance field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SchedulerInstanceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SchedulerInstanceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SchedulerInstanceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SchedulerInstanceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SchedulerInstanceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SchedulerInstanceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SchedulerInstanceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SchedulerInstance unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SchedulerInstanceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SchedulerInstance edge %s", name)
+}
+
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	dueDate             *time.Time
+	webhookUrl          *string
+	webhookHost         *string
+	method              *string
+	headers             *map[string]string
+	body                *string
+	omitIdSuffix        *bool
+	cron                *string
+	status              *task.Status
+	attempts            *int
+	addattempts         *int
+	deliveryId          *string
+	leaseExpiresAt      *time.Time
+	reclaims            *int
+	addreclaims         *int
+	shard               *int
+	addshard            *int
+	maxAttempts         *int
+	addmaxAttempts      *int
+	retryBaseDelayMs    *int64
+	addretryBaseDelayMs *int64
+	retryMaxDelayMs     *int64
+	addretryMaxDelayMs  *int64
+	retryJitter         *float64
+	addretryJitter      *float64
+	caller              *string
+	idempotencyKey      *string
+	requestHash         *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	histories           map[int]struct{}
+	removedhistories    map[int]struct{}
+	clearedhistories    bool
+	done                bool
+	oldValue            func(context.Context) (*Task, error)
+	predicates          []predicate.Task
+}
+
+var _ ent.Mutation = (*TaskMutation)(nil)
+
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
+
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id int) taskOption {
+	return func(m *TaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Task
+		)
+		m.oldValue = func(ctx context.Context) (*Task, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Task.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDueDate sets the "dueDate" field.
+func (m *TaskMutation) SetDueDate(t time.Time) {
+	m.dueDate = &t
+}
+
+// DueDate returns the value of the "dueDate" field in the mutation.
+func (m *TaskMutation) DueDate() (r time.Time, exists bool) {
+	v := m.dueDate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueDate returns the old "dueDate" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDueDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
+	}
+	return oldValue.DueDate, nil
+}
+
+// ResetDueDate resets all changes to the "dueDate" field.
+func (m *TaskMutation) ResetDueDate() {
+	m.dueDate = nil
+}
+
+// SetWebhookUrl sets the "webhookUrl" field.
+func (m *TaskMutation) SetWebhookUrl(s string) {
+	m.webhookUrl = &s
+}
+
+// WebhookUrl returns the value of the "webhookUrl" field in the mutation.
+func (m *TaskMutation) WebhookUrl() (r string, exists bool) {
+	v := m.webhookUrl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookUrl returns the old "webhookUrl" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldWebhookUrl(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookUrl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookUrl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookUrl: %w", err)
+	}
+	return oldValue.WebhookUrl, nil
+}
+
+// ResetWebhookUrl resets all changes to the "webhookUrl" field.
+func (m *TaskMutation) ResetWebhookUrl() {
+	m.webhookUrl = nil
+}
+
+// SetWebhookHost sets the "webhookHost" field.
+func (m *TaskMutation) SetWebhookHost(s string) {
+	m.webhookHost = &s
+}
+
+// WebhookHost returns the value of the "webhookHost" field in the mutation.
+func (m *TaskMutation) WebhookHost() (r string, exists bool) {
+	v := m.webhookHost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookHost returns the old "webhookHost" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldWebhookHost(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookHost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookHost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookHost: %w", err)
+	}
+	return oldValue.WebhookHost, nil
+}
+
+// ResetWebhookHost resets all changes to the "webhookHost" field.
+func (m *TaskMutation) ResetWebhookHost() {
+	m.webhookHost = nil
+}
+
+// SetMethod sets the "method" field.
+func (m *TaskMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *TaskMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *TaskMutation) ResetMethod() {
+	m.method = nil
+}
+
+// SetHeaders sets the "headers" field.
+func (m *TaskMutation) SetHeaders(value map[string]string) {
+	m.headers = &value
+}
+
+// Headers returns the value of the "headers" field in the mutation.
+func (m *TaskMutation) Headers() (r map[string]string, exists bool) {
+	v := m.headers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeaders returns the old "headers" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldHeaders(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeaders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeaders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeaders: %w", err)
+	}
+	return oldValue.Headers, nil
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (m *TaskMutation) ClearHeaders() {
+	m.headers = nil
+	m.clearedFields[task.FieldHeaders] = struct{}{}
+}
+
+// HeadersCleared returns if the "headers" field was cleared in this mutation.
+func (m *TaskMutation) HeadersCleared() bool {
+	_, ok := m.clearedFields[task.FieldHeaders]
+	return ok
+}
+
+// ResetHeaders resets all changes to the "headers" field.
+func (m *TaskMutation) ResetHeaders() {
+	m.headers = nil
+	delete(m.clearedFields, task.FieldHeaders)
+}
+
+// SetBody sets the "body" field.
+func (m *TaskMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *TaskMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ClearBody clears the value of the "body" field.
+func (m *TaskMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[task.FieldBody] = struct{}{}
+}
+
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *TaskMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[task.FieldBody]
+	return ok
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *TaskMutation) ResetBody() {
+	m.body = nil
+	delete(m.clearedFields, task.FieldBody)
+}
+
+// SetOmitIdSuffix sets the "omitIdSuffix" field.
+func (m *TaskMutation) SetOmitIdSuffix(b bool) {
+	m.omitIdSuffix = &b
+}
+
+// OmitIdSuffix returns the value of the "omitIdSuffix" field in the mutation.
+func (m *TaskMutation) OmitIdSuffix() (r bool, exists bool) {
+	v := m.omitIdSuffix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOmitIdSuffix returns the old "omitIdSuffix" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOmitIdSuffix(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOmitIdSuffix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOmitIdSuffix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOmitIdSuffix: %w", err)
+	}
+	return oldValue.OmitIdSuffix, nil
+}
+
+// ResetOmitIdSuffix resets all changes to the "omitIdSuffix" field.
+func (m *TaskMutation) ResetOmitIdSuffix() {
+	m.omitIdSuffix = nil
+}
+
+// SetCron sets the "cron" field.
+func (m *TaskMutation) SetCron(s string) {
+	m.cron = &s
+}
+
+// Cron returns the value of the "cron" field in the mutation.
+func (m *TaskMutation) Cron() (r string, exists bool) {
+	v := m.cron
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCron returns the old "cron" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCron(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCron is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCron requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCron: %w", err)
+	}
+	return oldValue.Cron, nil
+}
+
+// ClearCron clears the value of the "cron" field.
+func (m *TaskMutation) ClearCron() {
+	m.cron = nil
+	m.clearedFields[task.FieldCron] = struct{}{}
+}
+
+// CronCleared returns if the "cron" field was cleared in this mutation.
+func (m *TaskMutation) CronCleared() bool {
+	_, ok := m.clearedFields[task.FieldCron]
+	return ok
+}
+
+// ResetCron resets all changes to the "cron" field.
+func (m *TaskMutation) ResetCron() {
+	m.cron = nil
+	delete(m.clearedFields, task.FieldCron)
+}
+
+// SetStatus sets the "status" field.
+func (m *TaskMutation) SetStatus(t task.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TaskMutation) Status() (r task.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldStatus(ctx context.Context) (v task.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TaskMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *TaskMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *TaskMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *TaskMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *TaskMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *TaskMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetDeliveryId sets the "deliveryId" field.
+func (m *TaskMutation) SetDeliveryId(s string) {
+	m.deliveryId = &s
+}
+
+// DeliveryId returns the value of the "deliveryId" field in the mutation.
+func (m *TaskMutation) DeliveryId() (r string, exists bool) {
+	v := m.deliveryId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveryId returns the old "deliveryId" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDeliveryId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveryId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveryId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveryId: %w", err)
+	}
+	return oldValue.DeliveryId, nil
+}
+
+// ClearDeliveryId clears the value of the "deliveryId" field.
+func (m *TaskMutation) ClearDeliveryId() {
+	m.deliveryId = nil
+	m.clearedFields[task.FieldDeliveryId] = struct{}{}
+}
+
+// DeliveryIdCleared returns if the "deliveryId" field was cleared in this mutation.
+func (m *TaskMutation) DeliveryIdCleared() bool {
+	_, ok := m.clearedFields[task.FieldDeliveryId]
+	return ok
+}
+
+// ResetDeliveryId resets all changes to the "deliveryId" field.
+func (m *TaskMutation) ResetDeliveryId() {
+	m.deliveryId = nil
+	delete(m.clearedFields, task.FieldDeliveryId)
+}
+
+// SetLeaseExpiresAt sets the "leaseExpiresAt" field.
+func (m *TaskMutation) SetLeaseExpiresAt(t time.Time) {
+	m.leaseExpiresAt = &t
+}
+
+// LeaseExpiresAt returns the value of the "leaseExpiresAt" field in the mutation.
+func (m *TaskMutation) LeaseExpiresAt() (r time.Time, exists bool) {
+	v := m.leaseExpiresAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseExpiresAt returns the old "leaseExpiresAt" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldLeaseExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseExpiresAt: %w", err)
+	}
+	return oldValue.LeaseExpiresAt, nil
+}
+
+// ClearLeaseExpiresAt clears the value of the "leaseExpiresAt" field.
+func (m *TaskMutation) ClearLeaseExpiresAt() {
+	m.leaseExpiresAt = nil
+	m.clearedFields[task.FieldLeaseExpiresAt] = struct{}{}
+}
+
+// LeaseExpiresAtCleared returns if the "leaseExpiresAt" field was cleared in this mutation.
+func (m *TaskMutation) LeaseExpiresAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldLeaseExpiresAt]
+	return ok
+}
+
+// ResetLeaseExpiresAt resets all changes to the "leaseExpiresAt" field.
+func (m *TaskMutation) ResetLeaseExpiresAt() {
+	m.leaseExpiresAt = nil
+	delete(m.clearedFields, task.FieldLeaseExpiresAt)
+}
+
+// SetReclaims sets the "reclaims" field.
+func (m *TaskMutation) SetReclaims(i int) {
+	m.reclaims = &i
+	m.addreclaims = nil
+}
+
+// Reclaims returns the value of the "reclaims" field in the mutation.
+func (m *TaskMutation) Reclaims() (r int, exists bool) {
+	v := m.reclaims
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReclaims returns the old "reclaims" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldReclaims(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReclaims is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReclaims requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReclaims: %w", err)
+	}
+	return oldValue.Reclaims, nil
+}
+
+// AddReclaims adds i to the "reclaims" field.
+func (m *TaskMutation) AddReclaims(i int) {
+	if m.addreclaims != nil {
+		*m.addreclaims += i
+	} else {
+		m.addreclaims = &i
+	}
+}
+
+// AddedReclaims returns the value that was added to the "reclaims" field in this mutation.
+func (m *TaskMutation) AddedReclaims() (r int, exists bool) {
+	v := m.addreclaims
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReclaims resets all changes to the "reclaims" field.
+func (m *TaskMutation) ResetReclaims() {
+	m.reclaims = nil
+	m.addreclaims = nil
+}
+
+// SetShard sets the "shard" field.
+func (m *TaskMutation) SetShard(i int) {
+	m.shard = &i
+	m.addshard = nil
+}
+
+// Shard returns the value of the "shard" field in the mutation.
+func (m *TaskMutation) Shard() (r int, exists bool) {
+	v := m.shard
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShard returns the old "shard" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldShard(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShard is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShard requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShard: %w", err)
+	}
+	return oldValue.Shard, nil
+}
+
+// AddShard adds i to the "shard" field.
+func (m *TaskMutation) AddShard(i int) {
+	if m.addshard != nil {
+		*m.addshard += i
+	} else {
+		m.addshard = &i
+	}
+}
+
+// AddedShard returns the value that was added to the "shard" field in this mutation.
+func (m *TaskMutation) AddedShard() (r int, exists bool) {
+	v := m.addshard
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetShard resets all changes to the "shard" field.
+func (m *TaskMutation) ResetShard() {
+	m.shard = nil
+	m.addshard = nil
+}
+
+// SetMaxAttempts sets the "maxAttempts" field.
+func (m *TaskMutation) SetMaxAttempts(i int) {
+	m.maxAttempts = &i
+	m.addmaxAttempts = nil
+}
+
+// MaxAttempts returns the value of the "maxAttempts" field in the mutation.
+func (m *TaskMutation) MaxAttempts() (r int, exists bool) {
+	v := m.maxAttempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAttempts returns the old "maxAttempts" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldMaxAttempts(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "maxAttempts" field.
+func (m *TaskMutation) AddMaxAttempts(i int) {
+	if m.addmaxAttempts != nil {
+		*m.addmaxAttempts += i
+	} else {
+		m.addmaxAttempts = &i
+	}
+}
+
+// AddedMaxAttempts returns the value that was added to the "maxAttempts" field in this mutation.
+func (m *TaskMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmaxAttempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxAttempts clears the value of the "maxAttempts" field.
+func (m *TaskMutation) ClearMaxAttempts() {
+	m.maxAttempts = nil
+	m.addmaxAttempts = nil
+	m.clearedFields[task.FieldMaxAttempts] = struct{}{}
+}
+
+// MaxAttemptsCleared returns if the "maxAttempts" field was cleared in this mutation.
+func (m *TaskMutation) MaxAttemptsCleared() bool {
+	_, ok := m.clearedFields[task.FieldMaxAttempts]
+	return ok
+}
+
+// ResetMaxAttempts resets all changes to the "maxAttempts" field.
+func (m *TaskMutation) ResetMaxAttempts() {
+	m.maxAttempts = nil
+	m.addmaxAttempts = nil
+	delete(m.clearedFields, task.FieldMaxAttempts)
+}
+
+// SetRetryBaseDelayMs sets the "retryBaseDelayMs" field.
+func (m *TaskMutation) SetRetryBaseDelayMs(i int64) {
+	m.retryBaseDelayMs = &i
+	m.addretryBaseDelayMs = nil
+}
+
+// RetryBaseDelayMs returns the value of the "retryBaseDelayMs" field in the mutation.
+func (m *TaskMutation) RetryBaseDelayMs() (r int64, exists bool) {
+	v := m.retryBaseDelayMs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryBaseDelayMs returns the old "retryBaseDelayMs" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldRetryBaseDelayMs(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryBaseDelayMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryBaseDelayMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryBaseDelayMs: %w", err)
+	}
+	return oldValue.RetryBaseDelayMs, nil
+}
+
+// AddRetryBaseDelayMs adds i to the "retryBaseDelayMs" field.
+func (m *TaskMutation) AddRetryBaseDelayMs(i int64) {
+	if m.addretryBaseDelayMs != nil {
+		*m.addretryBaseDelayMs += i
+	} else {
+		m.addretryBaseDelayMs = &i
+	}
+}
+
+// AddedRetryBaseDelayMs returns the value that was added to the "retryBaseDelayMs" field in this mutation.
+func (m *TaskMutation) AddedRetryBaseDelayMs() (r int64, exists bool) {
+	v := m.addretryBaseDelayMs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRetryBaseDelayMs clears the value of the "retryBaseDelayMs" field.
+func (m *TaskMutation) ClearRetryBaseDelayMs() {
+	m.retryBaseDelayMs = nil
+	m.addretryBaseDelayMs = nil
+	m.clearedFields[task.FieldRetryBaseDelayMs] = struct{}{}
+}
+
+// RetryBaseDelayMsCleared returns if the "retryBaseDelayMs" field was cleared in this mutation.
+func (m *TaskMutation) RetryBaseDelayMsCleared() bool {
+	_, ok := m.clearedFields[task.FieldRetryBaseDelayMs]
+	return ok
+}
+
+// ResetRetryBaseDelayMs resets all changes to the "retryBaseDelayMs" field.
+func (m *TaskMutation) ResetRetryBaseDelayMs() {
+	m.retryBaseDelayMs = nil
+	m.addretryBaseDelayMs = nil
+	delete(m.clearedFields, task.FieldRetryBaseDelayMs)
+}
+
+// SetRetryMaxDelayMs sets the "retryMaxDelayMs" field.
+func (m *TaskMutation) SetRetryMaxDelayMs(i int64) {
+	m.retryMaxDelayMs = &i
+	m.addretryMaxDelayMs = nil
+}
+
+// RetryMaxDelayMs returns the value of the "retryMaxDelayMs" field in the mutation.
+func (m *TaskMutation) RetryMaxDelayMs() (r int64, exists bool) {
+	v := m.retryMaxDelayMs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryMaxDelayMs returns the old "retryMaxDelayMs" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldRetryMaxDelayMs(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryMaxDelayMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryMaxDelayMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryMaxDelayMs: %w", err)
+	}
+	return oldValue.RetryMaxDelayMs, nil
+}
+
+// AddRetryMaxDelayMs adds i to the "retryMaxDelayMs" field.
+func (m *TaskMutation) AddRetryMaxDelayMs(i int64) {
+	if m.addretryMaxDelayMs != nil {
+		*m.addretryMaxDelayMs += i
+	} else {
+		m.addretryMaxDelayMs = &i
+	}
+}
+
+// AddedRetryMaxDelayMs returns the value that was added to the "retryMaxDelayMs" field in this mutation.
+func (m *TaskMutation) AddedRetryMaxDelayMs() (r int64, exists bool) {
+	v := m.addretryMaxDelayMs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRetryMaxDelayMs clears the value of the "retryMaxDelayMs" field.
+func (m *TaskMutation) ClearRetryMaxDelayMs() {
+	m.retryMaxDelayMs = nil
+	m.addretryMaxDelayMs = nil
+	m.clearedFields[task.FieldRetryMaxDelayMs] = struct{}{}
+}
+
+// RetryMaxDelayMsCleared returns if the "retryMaxDelayMs" field was cleared in this mutation.
+func (m *TaskMutation) RetryMaxDelayMsCleared() bool {
+	_, ok := m.clearedFields[task.FieldRetryMaxDelayMs]
+	return ok
+}
+
+// ResetRetryMaxDelayMs resets all changes to the "retryMaxDelayMs" field.
+func (m *TaskMutation) ResetRetryMaxDelayMs() {
+	m.retryMaxDelayMs = nil
+	m.addretryMaxDelayMs = nil
+	delete(m.clearedFields, task.FieldRetryMaxDelayMs)
+}
+
+// SetRetryJitter sets the "retryJitter" field.
+func (m *TaskMutation) SetRetryJitter(f float64) {
+	m.retryJitter = &f
+	m.addretryJitter = nil
 }
 
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// RetryJitter returns the value of the "retryJitter" field in the mutation.
+func (m *TaskMutation) RetryJitter() (r float64, exists bool) {
+	v := m.retryJitter
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldRetryJitter returns the old "retryJitter" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldRetryJitter(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryJitter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryJitter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryJitter: %w", err)
+	}
+	return oldValue.RetryJitter, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddRetryJitter adds f to the "retryJitter" field.
+func (m *TaskMutation) AddRetryJitter(f float64) {
+	if m.addretryJitter != nil {
+		*m.addretryJitter += f
+	} else {
+		m.addretryJitter = &f
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedRetryJitter returns the value that was added to the "retryJitter" field in this mutation.
+func (m *TaskMutation) AddedRetryJitter() (r float64, exists bool) {
+	v := m.addretryJitter
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ClearRetryJitter clears the value of the "retryJitter" field.
+func (m *TaskMutation) ClearRetryJitter() {
+	m.retryJitter = nil
+	m.addretryJitter = nil
+	m.clearedFields[task.FieldRetryJitter] = struct{}{}
 }
 
-// SetDueDate sets the "dueDate" field.
-func (m *TaskMutation) SetDueDate(t time.Time) {
-	m.dueDate = &t
+// RetryJitterCleared returns if the "retryJitter" field was cleared in this mutation.
+func (m *TaskMutation) RetryJitterCleared() bool {
+	_, ok := m.clearedFields[task.FieldRetryJitter]
+	return ok
 }
 
-// DueDate returns the value of the "dueDate" field in the mutation.
-func (m *TaskMutation) DueDate() (r time.Time, exists bool) {
-	v := m.dueDate
+// ResetRetryJitter resets all changes to the "retryJitter" field.
+func (m *TaskMutation) ResetRetryJitter() {
+	m.retryJitter = nil
+	m.addretryJitter = nil
+	delete(m.clearedFields, task.FieldRetryJitter)
+}
+
+// SetCaller sets the "caller" field.
+func (m *TaskMutation) SetCaller(s string) {
+	m.caller = &s
+}
+
+// Caller returns the value of the "caller" field in the mutation.
+func (m *TaskMutation) Caller() (r string, exists bool) {
+	v := m.caller
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDueDate returns the old "dueDate" field's value of the Task entity.
+// OldCaller returns the old "caller" field's value of the Task entity.
 // If the Task object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDueDate(ctx context.Context) (v time.Time, err error) {
+func (m *TaskMutation) OldCaller(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldCaller is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDueDate requires an ID field in the mutation")
+		return v, errors.New("OldCaller requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldCaller: %w", err)
 	}
-	return oldValue.DueDate, nil
+	return oldValue.Caller, nil
 }
 
-// ResetDueDate resets all changes to the "dueDate" field.
-func (m *TaskMutation) ResetDueDate() {
-	m.dueDate = nil
+// ResetCaller resets all changes to the "caller" field.
+func (m *TaskMutation) ResetCaller() {
+	m.caller = nil
 }
 
-// SetWebhookUrl sets the "webhookUrl" field.
-func (m *TaskMutation) SetWebhookUrl(s string) {
-	m.webhookUrl = &s
+// SetIdempotencyKey sets the "idempotencyKey" field.
+func (m *TaskMutation) SetIdempotencyKey(s string) {
+	m.idempotencyKey = &s
 }
 
-// WebhookUrl returns the value of the "webhookUrl" field in the mutation.
-func (m *TaskMutation) WebhookUrl() (r string, exists bool) {
-	v := m.webhookUrl
+// IdempotencyKey returns the value of the "idempotencyKey" field in the mutation.
+func (m *TaskMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotencyKey
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWebhookUrl returns the old "webhookUrl" field's value of the Task entity.
+// OldIdempotencyKey returns the old "idempotencyKey" field's value of the Task entity.
 // If the Task object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldWebhookUrl(ctx context.Context) (v string, err error) {
+func (m *TaskMutation) OldIdempotencyKey(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWebhookUrl is only allowed on UpdateOne operations")
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWebhookUrl requires an ID field in the mutation")
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWebhookUrl: %w", err)
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
 	}
-	return oldValue.WebhookUrl, nil
+	return oldValue.IdempotencyKey, nil
 }
 
-// ResetWebhookUrl resets all changes to the "webhookUrl" field.
-func (m *TaskMutation) ResetWebhookUrl() {
-	m.webhookUrl = nil
+// ClearIdempotencyKey clears the value of the "idempotencyKey" field.
+func (m *TaskMutation) ClearIdempotencyKey() {
+	m.idempotencyKey = nil
+	m.clearedFields[task.FieldIdempotencyKey] = struct{}{}
 }
 
-// SetStatus sets the "status" field.
-func (m *TaskMutation) SetStatus(t task.Status) {
-	m.status = &t
+// IdempotencyKeyCleared returns if the "idempotencyKey" field was cleared in this mutation.
+func (m *TaskMutation) IdempotencyKeyCleared() bool {
+	_, ok := m.clearedFields[task.FieldIdempotencyKey]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TaskMutation) Status() (r task.Status, exists bool) {
-	v := m.status
+// ResetIdempotencyKey resets all changes to the "idempotencyKey" field.
+func (m *TaskMutation) ResetIdempotencyKey() {
+	m.idempotencyKey = nil
+	delete(m.clearedFields, task.FieldIdempotencyKey)
+}
+
+// SetRequestHash sets the "requestHash" field.
+func (m *TaskMutation) SetRequestHash(s string) {
+	m.requestHash = &s
+}
+
+// RequestHash returns the value of the "requestHash" field in the mutation.
+func (m *TaskMutation) RequestHash() (r string, exists bool) {
+	v := m.requestHash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Task entity.
+// OldRequestHash returns the old "requestHash" field's value of the Task entity.
 // If the Task object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldStatus(ctx context.Context) (v task.Status, err error) {
+func (m *TaskMutation) OldRequestHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldRequestHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestHash: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.RequestHash, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TaskMutation) ResetStatus() {
-	m.status = nil
+// ClearRequestHash clears the value of the "requestHash" field.
+func (m *TaskMutation) ClearRequestHash() {
+	m.requestHash = nil
+	m.clearedFields[task.FieldRequestHash] = struct{}{}
+}
+
+// RequestHashCleared returns if the "requestHash" field was cleared in this mutation.
+func (m *TaskMutation) RequestHashCleared() bool {
+	_, ok := m.clearedFields[task.FieldRequestHash]
+	return ok
+}
+
+// ResetRequestHash resets all changes to the "requestHash" field.
+func (m *TaskMutation) ResetRequestHash() {
+	m.requestHash = nil
+	delete(m.clearedFields, task.FieldRequestHash)
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -399,16 +3300,70 @@ func (m *TaskMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 23)
 	if m.dueDate != nil {
 		fields = append(fields, task.FieldDueDate)
 	}
 	if m.webhookUrl != nil {
 		fields = append(fields, task.FieldWebhookUrl)
 	}
+	if m.webhookHost != nil {
+		fields = append(fields, task.FieldWebhookHost)
+	}
+	if m.method != nil {
+		fields = append(fields, task.FieldMethod)
+	}
+	if m.headers != nil {
+		fields = append(fields, task.FieldHeaders)
+	}
+	if m.body != nil {
+		fields = append(fields, task.FieldBody)
+	}
+	if m.omitIdSuffix != nil {
+		fields = append(fields, task.FieldOmitIdSuffix)
+	}
+	if m.cron != nil {
+		fields = append(fields, task.FieldCron)
+	}
 	if m.status != nil {
 		fields = append(fields, task.FieldStatus)
 	}
+	if m.attempts != nil {
+		fields = append(fields, task.FieldAttempts)
+	}
+	if m.deliveryId != nil {
+		fields = append(fields, task.FieldDeliveryId)
+	}
+	if m.leaseExpiresAt != nil {
+		fields = append(fields, task.FieldLeaseExpiresAt)
+	}
+	if m.reclaims != nil {
+		fields = append(fields, task.FieldReclaims)
+	}
+	if m.shard != nil {
+		fields = append(fields, task.FieldShard)
+	}
+	if m.maxAttempts != nil {
+		fields = append(fields, task.FieldMaxAttempts)
+	}
+	if m.retryBaseDelayMs != nil {
+		fields = append(fields, task.FieldRetryBaseDelayMs)
+	}
+	if m.retryMaxDelayMs != nil {
+		fields = append(fields, task.FieldRetryMaxDelayMs)
+	}
+	if m.retryJitter != nil {
+		fields = append(fields, task.FieldRetryJitter)
+	}
+	if m.caller != nil {
+		fields = append(fields, task.FieldCaller)
+	}
+	if m.idempotencyKey != nil {
+		fields = append(fields, task.FieldIdempotencyKey)
+	}
+	if m.requestHash != nil {
+		fields = append(fields, task.FieldRequestHash)
+	}
 	if m.created_at != nil {
 		fields = append(fields, task.FieldCreatedAt)
 	}
@@ -427,8 +3382,44 @@ func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 		return m.DueDate()
 	case task.FieldWebhookUrl:
 		return m.WebhookUrl()
+	case task.FieldWebhookHost:
+		return m.WebhookHost()
+	case task.FieldMethod:
+		return m.Method()
+	case task.FieldHeaders:
+		return m.Headers()
+	case task.FieldBody:
+		return m.Body()
+	case task.FieldOmitIdSuffix:
+		return m.OmitIdSuffix()
+	case task.FieldCron:
+		return m.Cron()
 	case task.FieldStatus:
 		return m.Status()
+	case task.FieldAttempts:
+		return m.Attempts()
+	case task.FieldDeliveryId:
+		return m.DeliveryId()
+	case task.FieldLeaseExpiresAt:
+		return m.LeaseExpiresAt()
+	case task.FieldReclaims:
+		return m.Reclaims()
+	case task.FieldShard:
+		return m.Shard()
+	case task.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case task.FieldRetryBaseDelayMs:
+		return m.RetryBaseDelayMs()
+	case task.FieldRetryMaxDelayMs:
+		return m.RetryMaxDelayMs()
+	case task.FieldRetryJitter:
+		return m.RetryJitter()
+	case task.FieldCaller:
+		return m.Caller()
+	case task.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	case task.FieldRequestHash:
+		return m.RequestHash()
 	case task.FieldCreatedAt:
 		return m.CreatedAt()
 	case task.FieldUpdatedAt:
@@ -446,8 +3437,44 @@ func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldDueDate(ctx)
 	case task.FieldWebhookUrl:
 		return m.OldWebhookUrl(ctx)
+	case task.FieldWebhookHost:
+		return m.OldWebhookHost(ctx)
+	case task.FieldMethod:
+		return m.OldMethod(ctx)
+	case task.FieldHeaders:
+		return m.OldHeaders(ctx)
+	case task.FieldBody:
+		return m.OldBody(ctx)
+	case task.FieldOmitIdSuffix:
+		return m.OldOmitIdSuffix(ctx)
+	case task.FieldCron:
+		return m.OldCron(ctx)
 	case task.FieldStatus:
 		return m.OldStatus(ctx)
+	case task.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case task.FieldDeliveryId:
+		return m.OldDeliveryId(ctx)
+	case task.FieldLeaseExpiresAt:
+		return m.OldLeaseExpiresAt(ctx)
+	case task.FieldReclaims:
+		return m.OldReclaims(ctx)
+	case task.FieldShard:
+		return m.OldShard(ctx)
+	case task.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case task.FieldRetryBaseDelayMs:
+		return m.OldRetryBaseDelayMs(ctx)
+	case task.FieldRetryMaxDelayMs:
+		return m.OldRetryMaxDelayMs(ctx)
+	case task.FieldRetryJitter:
+		return m.OldRetryJitter(ctx)
+	case task.FieldCaller:
+		return m.OldCaller(ctx)
+	case task.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	case task.FieldRequestHash:
+		return m.OldRequestHash(ctx)
 	case task.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case task.FieldUpdatedAt:
@@ -475,6 +3502,48 @@ func (m *TaskMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetWebhookUrl(v)
 		return nil
+	case task.FieldWebhookHost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookHost(v)
+		return nil
+	case task.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case task.FieldHeaders:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeaders(v)
+		return nil
+	case task.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case task.FieldOmitIdSuffix:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOmitIdSuffix(v)
+		return nil
+	case task.FieldCron:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCron(v)
+		return nil
 	case task.FieldStatus:
 		v, ok := value.(task.Status)
 		if !ok {
@@ -482,6 +3551,90 @@ func (m *TaskMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetStatus(v)
 		return nil
+	case task.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case task.FieldDeliveryId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveryId(v)
+		return nil
+	case task.FieldLeaseExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpiresAt(v)
+		return nil
+	case task.FieldReclaims:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReclaims(v)
+		return nil
+	case task.FieldShard:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShard(v)
+		return nil
+	case task.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case task.FieldRetryBaseDelayMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryBaseDelayMs(v)
+		return nil
+	case task.FieldRetryMaxDelayMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryMaxDelayMs(v)
+		return nil
+	case task.FieldRetryJitter:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryJitter(v)
+		return nil
+	case task.FieldCaller:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaller(v)
+		return nil
+	case task.FieldIdempotencyKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdempotencyKey(v)
+		return nil
+	case task.FieldRequestHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHash(v)
+		return nil
 	case task.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -503,13 +3656,51 @@ func (m *TaskMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *TaskMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, task.FieldAttempts)
+	}
+	if m.addreclaims != nil {
+		fields = append(fields, task.FieldReclaims)
+	}
+	if m.addshard != nil {
+		fields = append(fields, task.FieldShard)
+	}
+	if m.addmaxAttempts != nil {
+		fields = append(fields, task.FieldMaxAttempts)
+	}
+	if m.addretryBaseDelayMs != nil {
+		fields = append(fields, task.FieldRetryBaseDelayMs)
+	}
+	if m.addretryMaxDelayMs != nil {
+		fields = append(fields, task.FieldRetryMaxDelayMs)
+	}
+	if m.addretryJitter != nil {
+		fields = append(fields, task.FieldRetryJitter)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldAttempts:
+		return m.AddedAttempts()
+	case task.FieldReclaims:
+		return m.AddedReclaims()
+	case task.FieldShard:
+		return m.AddedShard()
+	case task.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	case task.FieldRetryBaseDelayMs:
+		return m.AddedRetryBaseDelayMs()
+	case task.FieldRetryMaxDelayMs:
+		return m.AddedRetryMaxDelayMs()
+	case task.FieldRetryJitter:
+		return m.AddedRetryJitter()
+	}
 	return nil, false
 }
 
@@ -518,6 +3709,55 @@ func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *TaskMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case task.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case task.FieldReclaims:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReclaims(v)
+		return nil
+	case task.FieldShard:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShard(v)
+		return nil
+	case task.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	case task.FieldRetryBaseDelayMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetryBaseDelayMs(v)
+		return nil
+	case task.FieldRetryMaxDelayMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetryMaxDelayMs(v)
+		return nil
+	case task.FieldRetryJitter:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetryJitter(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Task numeric field %s", name)
 }
@@ -525,7 +3765,41 @@ func (m *TaskMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *TaskMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(task.FieldHeaders) {
+		fields = append(fields, task.FieldHeaders)
+	}
+	if m.FieldCleared(task.FieldBody) {
+		fields = append(fields, task.FieldBody)
+	}
+	if m.FieldCleared(task.FieldCron) {
+		fields = append(fields, task.FieldCron)
+	}
+	if m.FieldCleared(task.FieldDeliveryId) {
+		fields = append(fields, task.FieldDeliveryId)
+	}
+	if m.FieldCleared(task.FieldLeaseExpiresAt) {
+		fields = append(fields, task.FieldLeaseExpiresAt)
+	}
+	if m.FieldCleared(task.FieldMaxAttempts) {
+		fields = append(fields, task.FieldMaxAttempts)
+	}
+	if m.FieldCleared(task.FieldRetryBaseDelayMs) {
+		fields = append(fields, task.FieldRetryBaseDelayMs)
+	}
+	if m.FieldCleared(task.FieldRetryMaxDelayMs) {
+		fields = append(fields, task.FieldRetryMaxDelayMs)
+	}
+	if m.FieldCleared(task.FieldRetryJitter) {
+		fields = append(fields, task.FieldRetryJitter)
+	}
+	if m.FieldCleared(task.FieldIdempotencyKey) {
+		fields = append(fields, task.FieldIdempotencyKey)
+	}
+	if m.FieldCleared(task.FieldRequestHash) {
+		fields = append(fields, task.FieldRequestHash)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -538,6 +3812,41 @@ func (m *TaskMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *TaskMutation) ClearField(name string) error {
+	switch name {
+	case task.FieldHeaders:
+		m.ClearHeaders()
+		return nil
+	case task.FieldBody:
+		m.ClearBody()
+		return nil
+	case task.FieldCron:
+		m.ClearCron()
+		return nil
+	case task.FieldDeliveryId:
+		m.ClearDeliveryId()
+		return nil
+	case task.FieldLeaseExpiresAt:
+		m.ClearLeaseExpiresAt()
+		return nil
+	case task.FieldMaxAttempts:
+		m.ClearMaxAttempts()
+		return nil
+	case task.FieldRetryBaseDelayMs:
+		m.ClearRetryBaseDelayMs()
+		return nil
+	case task.FieldRetryMaxDelayMs:
+		m.ClearRetryMaxDelayMs()
+		return nil
+	case task.FieldRetryJitter:
+		m.ClearRetryJitter()
+		return nil
+	case task.FieldIdempotencyKey:
+		m.ClearIdempotencyKey()
+		return nil
+	case task.FieldRequestHash:
+		m.ClearRequestHash()
+		return nil
+	}
 	return fmt.Errorf("unknown Task nullable field %s", name)
 }
 
@@ -551,9 +3860,63 @@ func (m *TaskMutation) ResetField(name string) error {
 	case task.FieldWebhookUrl:
 		m.ResetWebhookUrl()
 		return nil
+	case task.FieldWebhookHost:
+		m.ResetWebhookHost()
+		return nil
+	case task.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case task.FieldHeaders:
+		m.ResetHeaders()
+		return nil
+	case task.FieldBody:
+		m.ResetBody()
+		return nil
+	case task.FieldOmitIdSuffix:
+		m.ResetOmitIdSuffix()
+		return nil
+	case task.FieldCron:
+		m.ResetCron()
+		return nil
 	case task.FieldStatus:
 		m.ResetStatus()
 		return nil
+	case task.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case task.FieldDeliveryId:
+		m.ResetDeliveryId()
+		return nil
+	case task.FieldLeaseExpiresAt:
+		m.ResetLeaseExpiresAt()
+		return nil
+	case task.FieldReclaims:
+		m.ResetReclaims()
+		return nil
+	case task.FieldShard:
+		m.ResetShard()
+		return nil
+	case task.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case task.FieldRetryBaseDelayMs:
+		m.ResetRetryBaseDelayMs()
+		return nil
+	case task.FieldRetryMaxDelayMs:
+		m.ResetRetryMaxDelayMs()
+		return nil
+	case task.FieldRetryJitter:
+		m.ResetRetryJitter()
+		return nil
+	case task.FieldCaller:
+		m.ResetCaller()
+		return nil
+	case task.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	case task.FieldRequestHash:
+		m.ResetRequestHash()
+		return nil
 	case task.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -654,7 +4017,15 @@ type TaskHistoryMutation struct {
 	op            Op
 	typ           string
 	id            *int
+	event         *taskhistory.Event
 	error         *string
+	runAt         *time.Time
+	attempt       *int
+	addattempt    *int
+	httpStatus    *int
+	addhttpStatus *int
+	latencyMs     *int64
+	addlatencyMs  *int64
 	created_at    *time.Time
 	updated_at    *time.Time
 	clearedFields map[string]struct{}
@@ -763,6 +4134,42 @@ func (m *TaskHistoryMutation) IDs(ctx context.Context) ([]int, error) {
 	}
 }
 
+// SetEvent sets the "event" field.
+func (m *TaskHistoryMutation) SetEvent(t taskhistory.Event) {
+	m.event = &t
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *TaskHistoryMutation) Event() (r taskhistory.Event, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the TaskHistory entity.
+// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskHistoryMutation) OldEvent(ctx context.Context) (v taskhistory.Event, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *TaskHistoryMutation) ResetEvent() {
+	m.event = nil
+}
+
 // SetError sets the "error" field.
 func (m *TaskHistoryMutation) SetError(s string) {
 	m.error = &s
@@ -812,6 +4219,224 @@ func (m *TaskHistoryMutation) ResetError() {
 	delete(m.clearedFields, taskhistory.FieldError)
 }
 
+// SetRunAt sets the "runAt" field.
+func (m *TaskHistoryMutation) SetRunAt(t time.Time) {
+	m.runAt = &t
+}
+
+// RunAt returns the value of the "runAt" field in the mutation.
+func (m *TaskHistoryMutation) RunAt() (r time.Time, exists bool) {
+	v := m.runAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunAt returns the old "runAt" field's value of the TaskHistory entity.
+// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskHistoryMutation) OldRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunAt: %w", err)
+	}
+	return oldValue.RunAt, nil
+}
+
+// ResetRunAt resets all changes to the "runAt" field.
+func (m *TaskHistoryMutation) ResetRunAt() {
+	m.runAt = nil
+}
+
+// SetAttempt sets the "attempt" field.
+func (m *TaskHistoryMutation) SetAttempt(i int) {
+	m.attempt = &i
+	m.addattempt = nil
+}
+
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *TaskHistoryMutation) Attempt() (r int, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempt returns the old "attempt" field's value of the TaskHistory entity.
+// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskHistoryMutation) OldAttempt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
+	}
+	return oldValue.Attempt, nil
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (m *TaskHistoryMutation) AddAttempt(i int) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
+	}
+}
+
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *TaskHistoryMutation) AddedAttempt() (r int, exists bool) {
+	v := m.addattempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *TaskHistoryMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
+}
+
+// SetHttpStatus sets the "httpStatus" field.
+func (m *TaskHistoryMutation) SetHttpStatus(i int) {
+	m.httpStatus = &i
+	m.addhttpStatus = nil
+}
+
+// HttpStatus returns the value of the "httpStatus" field in the mutation.
+func (m *TaskHistoryMutation) HttpStatus() (r int, exists bool) {
+	v := m.httpStatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHttpStatus returns the old "httpStatus" field's value of the TaskHistory entity.
+// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskHistoryMutation) OldHttpStatus(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHttpStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHttpStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHttpStatus: %w", err)
+	}
+	return oldValue.HttpStatus, nil
+}
+
+// AddHttpStatus adds i to the "httpStatus" field.
+func (m *TaskHistoryMutation) AddHttpStatus(i int) {
+	if m.addhttpStatus != nil {
+		*m.addhttpStatus += i
+	} else {
+		m.addhttpStatus = &i
+	}
+}
+
+// AddedHttpStatus returns the value that was added to the "httpStatus" field in this mutation.
+func (m *TaskHistoryMutation) AddedHttpStatus() (r int, exists bool) {
+	v := m.addhttpStatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHttpStatus clears the value of the "httpStatus" field.
+func (m *TaskHistoryMutation) ClearHttpStatus() {
+	m.httpStatus = nil
+	m.addhttpStatus = nil
+	m.clearedFields[taskhistory.FieldHttpStatus] = struct{}{}
+}
+
+// HttpStatusCleared returns if the "httpStatus" field was cleared in this mutation.
+func (m *TaskHistoryMutation) HttpStatusCleared() bool {
+	_, ok := m.clearedFields[taskhistory.FieldHttpStatus]
+	return ok
+}
+
+// ResetHttpStatus resets all changes to the "httpStatus" field.
+func (m *TaskHistoryMutation) ResetHttpStatus() {
+	m.httpStatus = nil
+	m.addhttpStatus = nil
+	delete(m.clearedFields, taskhistory.FieldHttpStatus)
+}
+
+// SetLatencyMs sets the "latencyMs" field.
+func (m *TaskHistoryMutation) SetLatencyMs(i int64) {
+	m.latencyMs = &i
+	m.addlatencyMs = nil
+}
+
+// LatencyMs returns the value of the "latencyMs" field in the mutation.
+func (m *TaskHistoryMutation) LatencyMs() (r int64, exists bool) {
+	v := m.latencyMs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMs returns the old "latencyMs" field's value of the TaskHistory entity.
+// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskHistoryMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+	}
+	return oldValue.LatencyMs, nil
+}
+
+// AddLatencyMs adds i to the "latencyMs" field.
+func (m *TaskHistoryMutation) AddLatencyMs(i int64) {
+	if m.addlatencyMs != nil {
+		*m.addlatencyMs += i
+	} else {
+		m.addlatencyMs = &i
+	}
+}
+
+// AddedLatencyMs returns the value that was added to the "latencyMs" field in this mutation.
+func (m *TaskHistoryMutation) AddedLatencyMs() (r int64, exists bool) {
+	v := m.addlatencyMs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencyMs resets all changes to the "latencyMs" field.
+func (m *TaskHistoryMutation) ResetLatencyMs() {
+	m.latencyMs = nil
+	m.addlatencyMs = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *TaskHistoryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -942,10 +4567,25 @@ func (m *TaskHistoryMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TaskHistoryMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 8)
+	if m.event != nil {
+		fields = append(fields, taskhistory.FieldEvent)
+	}
 	if m.error != nil {
 		fields = append(fields, taskhistory.FieldError)
 	}
+	if m.runAt != nil {
+		fields = append(fields, taskhistory.FieldRunAt)
+	}
+	if m.attempt != nil {
+		fields = append(fields, taskhistory.FieldAttempt)
+	}
+	if m.httpStatus != nil {
+		fields = append(fields, taskhistory.FieldHttpStatus)
+	}
+	if m.latencyMs != nil {
+		fields = append(fields, taskhistory.FieldLatencyMs)
+	}
 	if m.created_at != nil {
 		fields = append(fields, taskhistory.FieldCreatedAt)
 	}
@@ -960,8 +4600,18 @@ func (m *TaskHistoryMutation) Fields() []string {
 // schema.
 func (m *TaskHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case taskhistory.FieldEvent:
+		return m.Event()
 	case taskhistory.FieldError:
 		return m.Error()
+	case taskhistory.FieldRunAt:
+		return m.RunAt()
+	case taskhistory.FieldAttempt:
+		return m.Attempt()
+	case taskhistory.FieldHttpStatus:
+		return m.HttpStatus()
+	case taskhistory.FieldLatencyMs:
+		return m.LatencyMs()
 	case taskhistory.FieldCreatedAt:
 		return m.CreatedAt()
 	case taskhistory.FieldUpdatedAt:
@@ -975,8 +4625,18 @@ func (m *TaskHistoryMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *TaskHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case taskhistory.FieldEvent:
+		return m.OldEvent(ctx)
 	case taskhistory.FieldError:
 		return m.OldError(ctx)
+	case taskhistory.FieldRunAt:
+		return m.OldRunAt(ctx)
+	case taskhistory.FieldAttempt:
+		return m.OldAttempt(ctx)
+	case taskhistory.FieldHttpStatus:
+		return m.OldHttpStatus(ctx)
+	case taskhistory.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
 	case taskhistory.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case taskhistory.FieldUpdatedAt:
@@ -990,6 +4650,13 @@ func (m *TaskHistoryMutation) OldField(ctx context.Context, name string) (ent.Va
 // type.
 func (m *TaskHistoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case taskhistory.FieldEvent:
+		v, ok := value.(taskhistory.Event)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
 	case taskhistory.FieldError:
 		v, ok := value.(string)
 		if !ok {
@@ -997,6 +4664,34 @@ func (m *TaskHistoryMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetError(v)
 		return nil
+	case taskhistory.FieldRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunAt(v)
+		return nil
+	case taskhistory.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempt(v)
+		return nil
+	case taskhistory.FieldHttpStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHttpStatus(v)
+		return nil
+	case taskhistory.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMs(v)
+		return nil
 	case taskhistory.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -1018,13 +4713,31 @@ func (m *TaskHistoryMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *TaskHistoryMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addattempt != nil {
+		fields = append(fields, taskhistory.FieldAttempt)
+	}
+	if m.addhttpStatus != nil {
+		fields = append(fields, taskhistory.FieldHttpStatus)
+	}
+	if m.addlatencyMs != nil {
+		fields = append(fields, taskhistory.FieldLatencyMs)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *TaskHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case taskhistory.FieldAttempt:
+		return m.AddedAttempt()
+	case taskhistory.FieldHttpStatus:
+		return m.AddedHttpStatus()
+	case taskhistory.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	}
 	return nil, false
 }
 
@@ -1033,6 +4746,27 @@ func (m *TaskHistoryMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *TaskHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case taskhistory.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempt(v)
+		return nil
+	case taskhistory.FieldHttpStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHttpStatus(v)
+		return nil
+	case taskhistory.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMs(v)
+		return nil
 	}
 	return fmt.Errorf("unknown TaskHistory numeric field %s", name)
 }
@@ -1044,6 +4778,9 @@ func (m *TaskHistoryMutation) ClearedFields() []string {
 	if m.FieldCleared(taskhistory.FieldError) {
 		fields = append(fields, taskhistory.FieldError)
 	}
+	if m.FieldCleared(taskhistory.FieldHttpStatus) {
+		fields = append(fields, taskhistory.FieldHttpStatus)
+	}
 	return fields
 }
 
@@ -1061,6 +4798,9 @@ func (m *TaskHistoryMutation) ClearField(name string) error {
 	case taskhistory.FieldError:
 		m.ClearError()
 		return nil
+	case taskhistory.FieldHttpStatus:
+		m.ClearHttpStatus()
+		return nil
 	}
 	return fmt.Errorf("unknown TaskHistory nullable field %s", name)
 }
@@ -1069,9 +4809,24 @@ func (m *TaskHistoryMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *TaskHistoryMutation) ResetField(name string) error {
 	switch name {
+	case taskhistory.FieldEvent:
+		m.ResetEvent()
+		return nil
 	case taskhistory.FieldError:
 		m.ResetError()
 		return nil
+	case taskhistory.FieldRunAt:
+		m.ResetRunAt()
+		return nil
+	case taskhistory.FieldAttempt:
+		m.ResetAttempt()
+		return nil
+	case taskhistory.FieldHttpStatus:
+		m.ResetHttpStatus()
+		return nil
+	case taskhistory.FieldLatencyMs:
+		m.ResetLatencyMs()
+		return nil
 	case taskhistory.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package outboxmessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TaskId applies equality check predicate on the "taskId" field. It's identical to TaskIdEQ.
+func TaskId(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaskId), v))
+	})
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v []byte) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPayload), v))
+	})
+}
+
+// SentAt applies equality check predicate on the "sentAt" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSentAt), v))
+	})
+}
+
+// RelayUntil applies equality check predicate on the "relayUntil" field. It's identical to RelayUntilEQ.
+func RelayUntil(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRelayUntil), v))
+	})
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempts), v))
+	})
+}
+
+// LastError applies equality check predicate on the "lastError" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastError), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TaskIdEQ applies the EQ predicate on the "taskId" field.
+func TaskIdEQ(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaskId), v))
+	})
+}
+
+// TaskIdNEQ applies the NEQ predicate on the "taskId" field.
+func TaskIdNEQ(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaskId), v))
+	})
+}
+
+// TaskIdIn applies the In predicate on the "taskId" field.
+func TaskIdIn(vs ...int) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldTaskId), v...))
+	})
+}
+
+// TaskIdNotIn applies the NotIn predicate on the "taskId" field.
+func TaskIdNotIn(vs ...int) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldTaskId), v...))
+	})
+}
+
+// TaskIdGT applies the GT predicate on the "taskId" field.
+func TaskIdGT(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaskId), v))
+	})
+}
+
+// TaskIdGTE applies the GTE predicate on the "taskId" field.
+func TaskIdGTE(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaskId), v))
+	})
+}
+
+// TaskIdLT applies the LT predicate on the "taskId" field.
+func TaskIdLT(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaskId), v))
+	})
+}
+
+// TaskIdLTE applies the LTE predicate on the "taskId" field.
+func TaskIdLTE(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaskId), v))
+	})
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v []byte) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v []byte) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...[]byte) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldPayload), v...))
+	})
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...[]byte) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldPayload), v...))
+	})
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v []byte) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v []byte) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v []byte) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v []byte) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPayload), v))
+	})
+}
+
+// SentAtEQ applies the EQ predicate on the "sentAt" field.
+func SentAtEQ(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sentAt" field.
+func SentAtNEQ(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtIn applies the In predicate on the "sentAt" field.
+func SentAtIn(vs ...time.Time) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSentAt), v...))
+	})
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sentAt" field.
+func SentAtNotIn(vs ...time.Time) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSentAt), v...))
+	})
+}
+
+// SentAtGT applies the GT predicate on the "sentAt" field.
+func SentAtGT(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtGTE applies the GTE predicate on the "sentAt" field.
+func SentAtGTE(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtLT applies the LT predicate on the "sentAt" field.
+func SentAtLT(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtLTE applies the LTE predicate on the "sentAt" field.
+func SentAtLTE(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sentAt" field.
+func SentAtIsNil() predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSentAt)))
+	})
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sentAt" field.
+func SentAtNotNil() predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSentAt)))
+	})
+}
+
+// RelayUntilEQ applies the EQ predicate on the "relayUntil" field.
+func RelayUntilEQ(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRelayUntil), v))
+	})
+}
+
+// RelayUntilNEQ applies the NEQ predicate on the "relayUntil" field.
+func RelayUntilNEQ(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRelayUntil), v))
+	})
+}
+
+// RelayUntilIn applies the In predicate on the "relayUntil" field.
+func RelayUntilIn(vs ...time.Time) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldRelayUntil), v...))
+	})
+}
+
+// RelayUntilNotIn applies the NotIn predicate on the "relayUntil" field.
+func RelayUntilNotIn(vs ...time.Time) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldRelayUntil), v...))
+	})
+}
+
+// RelayUntilGT applies the GT predicate on the "relayUntil" field.
+func RelayUntilGT(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRelayUntil), v))
+	})
+}
+
+// RelayUntilGTE applies the GTE predicate on the "relayUntil" field.
+func RelayUntilGTE(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRelayUntil), v))
+	})
+}
+
+// RelayUntilLT applies the LT predicate on the "relayUntil" field.
+func RelayUntilLT(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRelayUntil), v))
+	})
+}
+
+// RelayUntilLTE applies the LTE predicate on the "relayUntil" field.
+func RelayUntilLTE(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRelayUntil), v))
+	})
+}
+
+// RelayUntilIsNil applies the IsNil predicate on the "relayUntil" field.
+func RelayUntilIsNil() predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRelayUntil)))
+	})
+}
+
+// RelayUntilNotNil applies the NotNil predicate on the "relayUntil" field.
+func RelayUntilNotNil() predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRelayUntil)))
+	})
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAttempts), v...))
+	})
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAttempts), v...))
+	})
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAttempts), v))
+	})
+}
+
+// LastErrorEQ applies the EQ predicate on the "lastError" field.
+func LastErrorEQ(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "lastError" field.
+func LastErrorNEQ(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorIn applies the In predicate on the "lastError" field.
+func LastErrorIn(vs ...string) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLastError), v...))
+	})
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "lastError" field.
+func LastErrorNotIn(vs ...string) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLastError), v...))
+	})
+}
+
+// LastErrorGT applies the GT predicate on the "lastError" field.
+func LastErrorGT(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorGTE applies the GTE predicate on the "lastError" field.
+func LastErrorGTE(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorLT applies the LT predicate on the "lastError" field.
+func LastErrorLT(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorLTE applies the LTE predicate on the "lastError" field.
+func LastErrorLTE(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorContains applies the Contains predicate on the "lastError" field.
+func LastErrorContains(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "lastError" field.
+func LastErrorHasPrefix(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "lastError" field.
+func LastErrorHasSuffix(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "lastError" field.
+func LastErrorIsNil() predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastError)))
+	})
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "lastError" field.
+func LastErrorNotNil() predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastError)))
+	})
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "lastError" field.
+func LastErrorEqualFold(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "lastError" field.
+func LastErrorContainsFold(v string) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLastError), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OutboxMessage {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OutboxMessage) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OutboxMessage) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OutboxMessage) predicate.OutboxMessage {
+	return predicate.OutboxMessage(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,546 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/outboxmessage"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+)
+
+// OutboxMessageUpdate is the builder for updating OutboxMessage entities.
+type OutboxMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxMessageMutation
+}
+
+// Where appends a list predicates to the OutboxMessageUpdate builder.
+func (omu *OutboxMessageUpdate) Where(ps ...predicate.OutboxMessage) *OutboxMessageUpdate {
+	omu.mutation.Where(ps...)
+	return omu
+}
+
+// SetTaskId sets the "taskId" field.
+func (omu *OutboxMessageUpdate) SetTaskId(i int) *OutboxMessageUpdate {
+	omu.mutation.ResetTaskId()
+	omu.mutation.SetTaskId(i)
+	return omu
+}
+
+// AddTaskId adds i to the "taskId" field.
+func (omu *OutboxMessageUpdate) AddTaskId(i int) *OutboxMessageUpdate {
+	omu.mutation.AddTaskId(i)
+	return omu
+}
+
+// SetPayload sets the "payload" field.
+func (omu *OutboxMessageUpdate) SetPayload(b []byte) *OutboxMessageUpdate {
+	omu.mutation.SetPayload(b)
+	return omu
+}
+
+// SetSentAt sets the "sentAt" field.
+func (omu *OutboxMessageUpdate) SetSentAt(t time.Time) *OutboxMessageUpdate {
+	omu.mutation.SetSentAt(t)
+	return omu
+}
+
+// SetNillableSentAt sets the "sentAt" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableSentAt(t *time.Time) *OutboxMessageUpdate {
+	if t != nil {
+		omu.SetSentAt(*t)
+	}
+	return omu
+}
+
+// ClearSentAt clears the value of the "sentAt" field.
+func (omu *OutboxMessageUpdate) ClearSentAt() *OutboxMessageUpdate {
+	omu.mutation.ClearSentAt()
+	return omu
+}
+
+// SetRelayUntil sets the "relayUntil" field.
+func (omu *OutboxMessageUpdate) SetRelayUntil(t time.Time) *OutboxMessageUpdate {
+	omu.mutation.SetRelayUntil(t)
+	return omu
+}
+
+// SetNillableRelayUntil sets the "relayUntil" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableRelayUntil(t *time.Time) *OutboxMessageUpdate {
+	if t != nil {
+		omu.SetRelayUntil(*t)
+	}
+	return omu
+}
+
+// ClearRelayUntil clears the value of the "relayUntil" field.
+func (omu *OutboxMessageUpdate) ClearRelayUntil() *OutboxMessageUpdate {
+	omu.mutation.ClearRelayUntil()
+	return omu
+}
+
+// SetAttempts sets the "attempts" field.
+func (omu *OutboxMessageUpdate) SetAttempts(i int) *OutboxMessageUpdate {
+	omu.mutation.ResetAttempts()
+	omu.mutation.SetAttempts(i)
+	return omu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableAttempts(i *int) *OutboxMessageUpdate {
+	if i != nil {
+		omu.SetAttempts(*i)
+	}
+	return omu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (omu *OutboxMessageUpdate) AddAttempts(i int) *OutboxMessageUpdate {
+	omu.mutation.AddAttempts(i)
+	return omu
+}
+
+// SetLastError sets the "lastError" field.
+func (omu *OutboxMessageUpdate) SetLastError(s string) *OutboxMessageUpdate {
+	omu.mutation.SetLastError(s)
+	return omu
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableLastError(s *string) *OutboxMessageUpdate {
+	if s != nil {
+		omu.SetLastError(*s)
+	}
+	return omu
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (omu *OutboxMessageUpdate) ClearLastError() *OutboxMessageUpdate {
+	omu.mutation.ClearLastError()
+	return omu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (omu *OutboxMessageUpdate) SetCreatedAt(t time.Time) *OutboxMessageUpdate {
+	omu.mutation.SetCreatedAt(t)
+	return omu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableCreatedAt(t *time.Time) *OutboxMessageUpdate {
+	if t != nil {
+		omu.SetCreatedAt(*t)
+	}
+	return omu
+}
+
+// Mutation returns the OutboxMessageMutation object of the builder.
+func (omu *OutboxMessageUpdate) Mutation() *OutboxMessageMutation {
+	return omu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (omu *OutboxMessageUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(omu.hooks) == 0 {
+		affected, err = omu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OutboxMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			omu.mutation = mutation
+			affected, err = omu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(omu.hooks) - 1; i >= 0; i-- {
+			if omu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = omu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, omu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omu *OutboxMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := omu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (omu *OutboxMessageUpdate) Exec(ctx context.Context) error {
+	_, err := omu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omu *OutboxMessageUpdate) ExecX(ctx context.Context) {
+	if err := omu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (omu *OutboxMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   outboxmessage.Table,
+			Columns: outboxmessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: outboxmessage.FieldID,
+			},
+		},
+	}
+	if ps := omu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := omu.mutation.TaskId(); ok {
+		_spec.SetField(outboxmessage.FieldTaskId, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.AddedTaskId(); ok {
+		_spec.AddField(outboxmessage.FieldTaskId, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.Payload(); ok {
+		_spec.SetField(outboxmessage.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := omu.mutation.SentAt(); ok {
+		_spec.SetField(outboxmessage.FieldSentAt, field.TypeTime, value)
+	}
+	if omu.mutation.SentAtCleared() {
+		_spec.ClearField(outboxmessage.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := omu.mutation.RelayUntil(); ok {
+		_spec.SetField(outboxmessage.FieldRelayUntil, field.TypeTime, value)
+	}
+	if omu.mutation.RelayUntilCleared() {
+		_spec.ClearField(outboxmessage.FieldRelayUntil, field.TypeTime)
+	}
+	if value, ok := omu.mutation.Attempts(); ok {
+		_spec.SetField(outboxmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.LastError(); ok {
+		_spec.SetField(outboxmessage.FieldLastError, field.TypeString, value)
+	}
+	if omu.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxmessage.FieldLastError, field.TypeString)
+	}
+	if value, ok := omu.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxmessage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, omu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OutboxMessageUpdateOne is the builder for updating a single OutboxMessage entity.
+type OutboxMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxMessageMutation
+}
+
+// SetTaskId sets the "taskId" field.
+func (omuo *OutboxMessageUpdateOne) SetTaskId(i int) *OutboxMessageUpdateOne {
+	omuo.mutation.ResetTaskId()
+	omuo.mutation.SetTaskId(i)
+	return omuo
+}
+
+// AddTaskId adds i to the "taskId" field.
+func (omuo *OutboxMessageUpdateOne) AddTaskId(i int) *OutboxMessageUpdateOne {
+	omuo.mutation.AddTaskId(i)
+	return omuo
+}
+
+// SetPayload sets the "payload" field.
+func (omuo *OutboxMessageUpdateOne) SetPayload(b []byte) *OutboxMessageUpdateOne {
+	omuo.mutation.SetPayload(b)
+	return omuo
+}
+
+// SetSentAt sets the "sentAt" field.
+func (omuo *OutboxMessageUpdateOne) SetSentAt(t time.Time) *OutboxMessageUpdateOne {
+	omuo.mutation.SetSentAt(t)
+	return omuo
+}
+
+// SetNillableSentAt sets the "sentAt" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableSentAt(t *time.Time) *OutboxMessageUpdateOne {
+	if t != nil {
+		omuo.SetSentAt(*t)
+	}
+	return omuo
+}
+
+// ClearSentAt clears the value of the "sentAt" field.
+func (omuo *OutboxMessageUpdateOne) ClearSentAt() *OutboxMessageUpdateOne {
+	omuo.mutation.ClearSentAt()
+	return omuo
+}
+
+// SetRelayUntil sets the "relayUntil" field.
+func (omuo *OutboxMessageUpdateOne) SetRelayUntil(t time.Time) *OutboxMessageUpdateOne {
+	omuo.mutation.SetRelayUntil(t)
+	return omuo
+}
+
+// SetNillableRelayUntil sets the "relayUntil" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableRelayUntil(t *time.Time) *OutboxMessageUpdateOne {
+	if t != nil {
+		omuo.SetRelayUntil(*t)
+	}
+	return omuo
+}
+
+// ClearRelayUntil clears the value of the "relayUntil" field.
+func (omuo *OutboxMessageUpdateOne) ClearRelayUntil() *OutboxMessageUpdateOne {
+	omuo.mutation.ClearRelayUntil()
+	return omuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (omuo *OutboxMessageUpdateOne) SetAttempts(i int) *OutboxMessageUpdateOne {
+	omuo.mutation.ResetAttempts()
+	omuo.mutation.SetAttempts(i)
+	return omuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableAttempts(i *int) *OutboxMessageUpdateOne {
+	if i != nil {
+		omuo.SetAttempts(*i)
+	}
+	return omuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (omuo *OutboxMessageUpdateOne) AddAttempts(i int) *OutboxMessageUpdateOne {
+	omuo.mutation.AddAttempts(i)
+	return omuo
+}
+
+// SetLastError sets the "lastError" field.
+func (omuo *OutboxMessageUpdateOne) SetLastError(s string) *OutboxMessageUpdateOne {
+	omuo.mutation.SetLastError(s)
+	return omuo
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableLastError(s *string) *OutboxMessageUpdateOne {
+	if s != nil {
+		omuo.SetLastError(*s)
+	}
+	return omuo
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (omuo *OutboxMessageUpdateOne) ClearLastError() *OutboxMessageUpdateOne {
+	omuo.mutation.ClearLastError()
+	return omuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (omuo *OutboxMessageUpdateOne) SetCreatedAt(t time.Time) *OutboxMessageUpdateOne {
+	omuo.mutation.SetCreatedAt(t)
+	return omuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableCreatedAt(t *time.Time) *OutboxMessageUpdateOne {
+	if t != nil {
+		omuo.SetCreatedAt(*t)
+	}
+	return omuo
+}
+
+// Mutation returns the OutboxMessageMutation object of the builder.
+func (omuo *OutboxMessageUpdateOne) Mutation() *OutboxMessageMutation {
+	return omuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (omuo *OutboxMessageUpdateOne) Select(field string, fields ...string) *OutboxMessageUpdateOne {
+	omuo.fields = append([]string{field}, fields...)
+	return omuo
+}
+
+// Save executes the query and returns the updated OutboxMessage entity.
+func (omuo *OutboxMessageUpdateOne) Save(ctx context.Context) (*OutboxMessage, error) {
+	var (
+		err  error
+		node *OutboxMessage
+	)
+	if len(omuo.hooks) == 0 {
+		node, err = omuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OutboxMessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			omuo.mutation = mutation
+			node, err = omuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(omuo.hooks) - 1; i >= 0; i-- {
+			if omuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = omuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, omuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*OutboxMessage)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from OutboxMessageMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omuo *OutboxMessageUpdateOne) SaveX(ctx context.Context) *OutboxMessage {
+	node, err := omuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (omuo *OutboxMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := omuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omuo *OutboxMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := omuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (omuo *OutboxMessageUpdateOne) sqlSave(ctx context.Context) (_node *OutboxMessage, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   outboxmessage.Table,
+			Columns: outboxmessage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: outboxmessage.FieldID,
+			},
+		},
+	}
+	id, ok := omuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := omuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxmessage.FieldID)
+		for _, f := range fields {
+			if !outboxmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := omuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := omuo.mutation.TaskId(); ok {
+		_spec.SetField(outboxmessage.FieldTaskId, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.AddedTaskId(); ok {
+		_spec.AddField(outboxmessage.FieldTaskId, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.Payload(); ok {
+		_spec.SetField(outboxmessage.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := omuo.mutation.SentAt(); ok {
+		_spec.SetField(outboxmessage.FieldSentAt, field.TypeTime, value)
+	}
+	if omuo.mutation.SentAtCleared() {
+		_spec.ClearField(outboxmessage.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := omuo.mutation.RelayUntil(); ok {
+		_spec.SetField(outboxmessage.FieldRelayUntil, field.TypeTime, value)
+	}
+	if omuo.mutation.RelayUntilCleared() {
+		_spec.ClearField(outboxmessage.FieldRelayUntil, field.TypeTime)
+	}
+	if value, ok := omuo.mutation.Attempts(); ok {
+		_spec.SetField(outboxmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.LastError(); ok {
+		_spec.SetField(outboxmessage.FieldLastError, field.TypeString, value)
+	}
+	if omuo.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxmessage.FieldLastError, field.TypeString)
+	}
+	if value, ok := omuo.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxmessage.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &OutboxMessage{config: omuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, omuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -6,6 +6,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// OutboxMessage is the predicate function for outboxmessage builders.
+type OutboxMessage func(*sql.Selector)
+
+// QueueJob is the predicate function for queuejob builders.
+type QueueJob func(*sql.Selector)
+
+// SchedulerInstance is the predicate function for schedulerinstance builders.
+type SchedulerInstance func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+)
+
+// QueueJob is the model entity for the QueueJob schema.
+type QueueJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Queue holds the value of the "queue" field.
+	Queue string `json:"queue,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload []byte `json:"payload,omitempty"`
+	// VisibleAt holds the value of the "visibleAt" field.
+	VisibleAt time.Time `json:"visibleAt,omitempty"`
+	// Deliveries holds the value of the "deliveries" field.
+	Deliveries int `json:"deliveries,omitempty"`
+	// DeadAt holds the value of the "deadAt" field.
+	DeadAt *time.Time `json:"deadAt,omitempty"`
+	// DeadCount holds the value of the "deadCount" field.
+	DeadCount int `json:"deadCount,omitempty"`
+	// DeadReason holds the value of the "deadReason" field.
+	DeadReason string `json:"deadReason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QueueJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case queuejob.FieldPayload:
+			values[i] = new([]byte)
+		case queuejob.FieldID, queuejob.FieldDeliveries, queuejob.FieldDeadCount:
+			values[i] = new(sql.NullInt64)
+		case queuejob.FieldQueue, queuejob.FieldDeadReason:
+			values[i] = new(sql.NullString)
+		case queuejob.FieldVisibleAt, queuejob.FieldDeadAt, queuejob.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type QueueJob", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QueueJob fields.
+func (qj *QueueJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case queuejob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			qj.ID = int(value.Int64)
+		case queuejob.FieldQueue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field queue", values[i])
+			} else if value.Valid {
+				qj.Queue = value.String
+			}
+		case queuejob.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				qj.Payload = *value
+			}
+		case queuejob.FieldVisibleAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field visibleAt", values[i])
+			} else if value.Valid {
+				qj.VisibleAt = value.Time
+			}
+		case queuejob.FieldDeliveries:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deliveries", values[i])
+			} else if value.Valid {
+				qj.Deliveries = int(value.Int64)
+			}
+		case queuejob.FieldDeadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deadAt", values[i])
+			} else if value.Valid {
+				qj.DeadAt = new(time.Time)
+				*qj.DeadAt = value.Time
+			}
+		case queuejob.FieldDeadCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deadCount", values[i])
+			} else if value.Valid {
+				qj.DeadCount = int(value.Int64)
+			}
+		case queuejob.FieldDeadReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deadReason", values[i])
+			} else if value.Valid {
+				qj.DeadReason = value.String
+			}
+		case queuejob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				qj.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this QueueJob.
+// Note that you need to call QueueJob.Unwrap() before calling this method if this QueueJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qj *QueueJob) Update() *QueueJobUpdateOne {
+	return (&QueueJobClient{config: qj.config}).UpdateOne(qj)
+}
+
+// Unwrap unwraps the QueueJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qj *QueueJob) Unwrap() *QueueJob {
+	_tx, ok := qj.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QueueJob is not a transactional entity")
+	}
+	qj.config.driver = _tx.drv
+	return qj
+}
+
+// String implements the fmt.Stringer.
+func (qj *QueueJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("QueueJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qj.ID))
+	builder.WriteString("queue=")
+	builder.WriteString(qj.Queue)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", qj.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("visibleAt=")
+	builder.WriteString(qj.VisibleAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deliveries=")
+	builder.WriteString(fmt.Sprintf("%v", qj.Deliveries))
+	builder.WriteString(", ")
+	if v := qj.DeadAt; v != nil {
+		builder.WriteString("deadAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deadCount=")
+	builder.WriteString(fmt.Sprintf("%v", qj.DeadCount))
+	builder.WriteString(", ")
+	builder.WriteString("deadReason=")
+	builder.WriteString(qj.DeadReason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(qj.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QueueJobs is a parsable slice of QueueJob.
+type QueueJobs []*QueueJob
+
+func (qj QueueJobs) config(cfg config) {
+	for _i := range qj {
+		qj[_i].config = cfg
+	}
+}
@@ -0,0 +1,64 @@
+// Code generated by ent, DO NOT EDIT.
+
+package queuejob
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the queuejob type in the database.
+	Label = "queue_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQueue holds the string denoting the queue field in the database.
+	FieldQueue = "queue"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldVisibleAt holds the string denoting the visibleat field in the database.
+	FieldVisibleAt = "visible_at"
+	// FieldDeliveries holds the string denoting the deliveries field in the database.
+	FieldDeliveries = "deliveries"
+	// FieldDeadAt holds the string denoting the deadat field in the database.
+	FieldDeadAt = "dead_at"
+	// FieldDeadCount holds the string denoting the deadcount field in the database.
+	FieldDeadCount = "dead_count"
+	// FieldDeadReason holds the string denoting the deadreason field in the database.
+	FieldDeadReason = "dead_reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the queuejob in the database.
+	Table = "queue_jobs"
+)
+
+// Columns holds all SQL columns for queuejob fields.
+var Columns = []string{
+	FieldID,
+	FieldQueue,
+	FieldPayload,
+	FieldVisibleAt,
+	FieldDeliveries,
+	FieldDeadAt,
+	FieldDeadCount,
+	FieldDeadReason,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDeliveries holds the default value on creation for the "deliveries" field.
+	DefaultDeliveries int
+	// DefaultDeadCount holds the default value on creation for the "deadCount" field.
+	DefaultDeadCount int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
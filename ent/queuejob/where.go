@@ -0,0 +1,779 @@
+// Code generated by ent, DO NOT EDIT.
+
+package queuejob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Queue applies equality check predicate on the "queue" field. It's identical to QueueEQ.
+func Queue(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQueue), v))
+	})
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v []byte) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPayload), v))
+	})
+}
+
+// VisibleAt applies equality check predicate on the "visibleAt" field. It's identical to VisibleAtEQ.
+func VisibleAt(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVisibleAt), v))
+	})
+}
+
+// Deliveries applies equality check predicate on the "deliveries" field. It's identical to DeliveriesEQ.
+func Deliveries(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeliveries), v))
+	})
+}
+
+// DeadAt applies equality check predicate on the "deadAt" field. It's identical to DeadAtEQ.
+func DeadAt(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeadAt), v))
+	})
+}
+
+// DeadCount applies equality check predicate on the "deadCount" field. It's identical to DeadCountEQ.
+func DeadCount(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeadCount), v))
+	})
+}
+
+// DeadReason applies equality check predicate on the "deadReason" field. It's identical to DeadReasonEQ.
+func DeadReason(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeadReason), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// QueueEQ applies the EQ predicate on the "queue" field.
+func QueueEQ(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQueue), v))
+	})
+}
+
+// QueueNEQ applies the NEQ predicate on the "queue" field.
+func QueueNEQ(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQueue), v))
+	})
+}
+
+// QueueIn applies the In predicate on the "queue" field.
+func QueueIn(vs ...string) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldQueue), v...))
+	})
+}
+
+// QueueNotIn applies the NotIn predicate on the "queue" field.
+func QueueNotIn(vs ...string) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldQueue), v...))
+	})
+}
+
+// QueueGT applies the GT predicate on the "queue" field.
+func QueueGT(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQueue), v))
+	})
+}
+
+// QueueGTE applies the GTE predicate on the "queue" field.
+func QueueGTE(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQueue), v))
+	})
+}
+
+// QueueLT applies the LT predicate on the "queue" field.
+func QueueLT(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQueue), v))
+	})
+}
+
+// QueueLTE applies the LTE predicate on the "queue" field.
+func QueueLTE(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQueue), v))
+	})
+}
+
+// QueueContains applies the Contains predicate on the "queue" field.
+func QueueContains(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldQueue), v))
+	})
+}
+
+// QueueHasPrefix applies the HasPrefix predicate on the "queue" field.
+func QueueHasPrefix(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldQueue), v))
+	})
+}
+
+// QueueHasSuffix applies the HasSuffix predicate on the "queue" field.
+func QueueHasSuffix(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldQueue), v))
+	})
+}
+
+// QueueEqualFold applies the EqualFold predicate on the "queue" field.
+func QueueEqualFold(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldQueue), v))
+	})
+}
+
+// QueueContainsFold applies the ContainsFold predicate on the "queue" field.
+func QueueContainsFold(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldQueue), v))
+	})
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v []byte) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v []byte) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...[]byte) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldPayload), v...))
+	})
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...[]byte) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldPayload), v...))
+	})
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v []byte) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v []byte) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v []byte) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v []byte) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPayload), v))
+	})
+}
+
+// VisibleAtEQ applies the EQ predicate on the "visibleAt" field.
+func VisibleAtEQ(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVisibleAt), v))
+	})
+}
+
+// VisibleAtNEQ applies the NEQ predicate on the "visibleAt" field.
+func VisibleAtNEQ(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVisibleAt), v))
+	})
+}
+
+// VisibleAtIn applies the In predicate on the "visibleAt" field.
+func VisibleAtIn(vs ...time.Time) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldVisibleAt), v...))
+	})
+}
+
+// VisibleAtNotIn applies the NotIn predicate on the "visibleAt" field.
+func VisibleAtNotIn(vs ...time.Time) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldVisibleAt), v...))
+	})
+}
+
+// VisibleAtGT applies the GT predicate on the "visibleAt" field.
+func VisibleAtGT(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVisibleAt), v))
+	})
+}
+
+// VisibleAtGTE applies the GTE predicate on the "visibleAt" field.
+func VisibleAtGTE(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVisibleAt), v))
+	})
+}
+
+// VisibleAtLT applies the LT predicate on the "visibleAt" field.
+func VisibleAtLT(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVisibleAt), v))
+	})
+}
+
+// VisibleAtLTE applies the LTE predicate on the "visibleAt" field.
+func VisibleAtLTE(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVisibleAt), v))
+	})
+}
+
+// DeliveriesEQ applies the EQ predicate on the "deliveries" field.
+func DeliveriesEQ(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeliveries), v))
+	})
+}
+
+// DeliveriesNEQ applies the NEQ predicate on the "deliveries" field.
+func DeliveriesNEQ(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeliveries), v))
+	})
+}
+
+// DeliveriesIn applies the In predicate on the "deliveries" field.
+func DeliveriesIn(vs ...int) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDeliveries), v...))
+	})
+}
+
+// DeliveriesNotIn applies the NotIn predicate on the "deliveries" field.
+func DeliveriesNotIn(vs ...int) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDeliveries), v...))
+	})
+}
+
+// DeliveriesGT applies the GT predicate on the "deliveries" field.
+func DeliveriesGT(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeliveries), v))
+	})
+}
+
+// DeliveriesGTE applies the GTE predicate on the "deliveries" field.
+func DeliveriesGTE(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeliveries), v))
+	})
+}
+
+// DeliveriesLT applies the LT predicate on the "deliveries" field.
+func DeliveriesLT(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeliveries), v))
+	})
+}
+
+// DeliveriesLTE applies the LTE predicate on the "deliveries" field.
+func DeliveriesLTE(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeliveries), v))
+	})
+}
+
+// DeadAtEQ applies the EQ predicate on the "deadAt" field.
+func DeadAtEQ(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeadAt), v))
+	})
+}
+
+// DeadAtNEQ applies the NEQ predicate on the "deadAt" field.
+func DeadAtNEQ(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeadAt), v))
+	})
+}
+
+// DeadAtIn applies the In predicate on the "deadAt" field.
+func DeadAtIn(vs ...time.Time) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDeadAt), v...))
+	})
+}
+
+// DeadAtNotIn applies the NotIn predicate on the "deadAt" field.
+func DeadAtNotIn(vs ...time.Time) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDeadAt), v...))
+	})
+}
+
+// DeadAtGT applies the GT predicate on the "deadAt" field.
+func DeadAtGT(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeadAt), v))
+	})
+}
+
+// DeadAtGTE applies the GTE predicate on the "deadAt" field.
+func DeadAtGTE(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeadAt), v))
+	})
+}
+
+// DeadAtLT applies the LT predicate on the "deadAt" field.
+func DeadAtLT(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeadAt), v))
+	})
+}
+
+// DeadAtLTE applies the LTE predicate on the "deadAt" field.
+func DeadAtLTE(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeadAt), v))
+	})
+}
+
+// DeadAtIsNil applies the IsNil predicate on the "deadAt" field.
+func DeadAtIsNil() predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeadAt)))
+	})
+}
+
+// DeadAtNotNil applies the NotNil predicate on the "deadAt" field.
+func DeadAtNotNil() predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeadAt)))
+	})
+}
+
+// DeadCountEQ applies the EQ predicate on the "deadCount" field.
+func DeadCountEQ(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeadCount), v))
+	})
+}
+
+// DeadCountNEQ applies the NEQ predicate on the "deadCount" field.
+func DeadCountNEQ(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeadCount), v))
+	})
+}
+
+// DeadCountIn applies the In predicate on the "deadCount" field.
+func DeadCountIn(vs ...int) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDeadCount), v...))
+	})
+}
+
+// DeadCountNotIn applies the NotIn predicate on the "deadCount" field.
+func DeadCountNotIn(vs ...int) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDeadCount), v...))
+	})
+}
+
+// DeadCountGT applies the GT predicate on the "deadCount" field.
+func DeadCountGT(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeadCount), v))
+	})
+}
+
+// DeadCountGTE applies the GTE predicate on the "deadCount" field.
+func DeadCountGTE(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeadCount), v))
+	})
+}
+
+// DeadCountLT applies the LT predicate on the "deadCount" field.
+func DeadCountLT(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeadCount), v))
+	})
+}
+
+// DeadCountLTE applies the LTE predicate on the "deadCount" field.
+func DeadCountLTE(v int) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeadCount), v))
+	})
+}
+
+// DeadReasonEQ applies the EQ predicate on the "deadReason" field.
+func DeadReasonEQ(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeadReason), v))
+	})
+}
+
+// DeadReasonNEQ applies the NEQ predicate on the "deadReason" field.
+func DeadReasonNEQ(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeadReason), v))
+	})
+}
+
+// DeadReasonIn applies the In predicate on the "deadReason" field.
+func DeadReasonIn(vs ...string) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDeadReason), v...))
+	})
+}
+
+// DeadReasonNotIn applies the NotIn predicate on the "deadReason" field.
+func DeadReasonNotIn(vs ...string) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDeadReason), v...))
+	})
+}
+
+// DeadReasonGT applies the GT predicate on the "deadReason" field.
+func DeadReasonGT(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeadReason), v))
+	})
+}
+
+// DeadReasonGTE applies the GTE predicate on the "deadReason" field.
+func DeadReasonGTE(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeadReason), v))
+	})
+}
+
+// DeadReasonLT applies the LT predicate on the "deadReason" field.
+func DeadReasonLT(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeadReason), v))
+	})
+}
+
+// DeadReasonLTE applies the LTE predicate on the "deadReason" field.
+func DeadReasonLTE(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeadReason), v))
+	})
+}
+
+// DeadReasonContains applies the Contains predicate on the "deadReason" field.
+func DeadReasonContains(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDeadReason), v))
+	})
+}
+
+// DeadReasonHasPrefix applies the HasPrefix predicate on the "deadReason" field.
+func DeadReasonHasPrefix(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDeadReason), v))
+	})
+}
+
+// DeadReasonHasSuffix applies the HasSuffix predicate on the "deadReason" field.
+func DeadReasonHasSuffix(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDeadReason), v))
+	})
+}
+
+// DeadReasonIsNil applies the IsNil predicate on the "deadReason" field.
+func DeadReasonIsNil() predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeadReason)))
+	})
+}
+
+// DeadReasonNotNil applies the NotNil predicate on the "deadReason" field.
+func DeadReasonNotNil() predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeadReason)))
+	})
+}
+
+// DeadReasonEqualFold applies the EqualFold predicate on the "deadReason" field.
+func DeadReasonEqualFold(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDeadReason), v))
+	})
+}
+
+// DeadReasonContainsFold applies the ContainsFold predicate on the "deadReason" field.
+func DeadReasonContainsFold(v string) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDeadReason), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QueueJob {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QueueJob) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QueueJob) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QueueJob) predicate.QueueJob {
+	return predicate.QueueJob(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+)
+
+// QueueJobCreate is the builder for creating a QueueJob entity.
+type QueueJobCreate struct {
+	config
+	mutation *QueueJobMutation
+	hooks    []Hook
+}
+
+// SetQueue sets the "queue" field.
+func (qjc *QueueJobCreate) SetQueue(s string) *QueueJobCreate {
+	qjc.mutation.SetQueue(s)
+	return qjc
+}
+
+// SetPayload sets the "payload" field.
+func (qjc *QueueJobCreate) SetPayload(b []byte) *QueueJobCreate {
+	qjc.mutation.SetPayload(b)
+	return qjc
+}
+
+// SetVisibleAt sets the "visibleAt" field.
+func (qjc *QueueJobCreate) SetVisibleAt(t time.Time) *QueueJobCreate {
+	qjc.mutation.SetVisibleAt(t)
+	return qjc
+}
+
+// SetDeliveries sets the "deliveries" field.
+func (qjc *QueueJobCreate) SetDeliveries(i int) *QueueJobCreate {
+	qjc.mutation.SetDeliveries(i)
+	return qjc
+}
+
+// SetNillableDeliveries sets the "deliveries" field if the given value is not nil.
+func (qjc *QueueJobCreate) SetNillableDeliveries(i *int) *QueueJobCreate {
+	if i != nil {
+		qjc.SetDeliveries(*i)
+	}
+	return qjc
+}
+
+// SetDeadAt sets the "deadAt" field.
+func (qjc *QueueJobCreate) SetDeadAt(t time.Time) *QueueJobCreate {
+	qjc.mutation.SetDeadAt(t)
+	return qjc
+}
+
+// SetNillableDeadAt sets the "deadAt" field if the given value is not nil.
+func (qjc *QueueJobCreate) SetNillableDeadAt(t *time.Time) *QueueJobCreate {
+	if t != nil {
+		qjc.SetDeadAt(*t)
+	}
+	return qjc
+}
+
+// SetDeadCount sets the "deadCount" field.
+func (qjc *QueueJobCreate) SetDeadCount(i int) *QueueJobCreate {
+	qjc.mutation.SetDeadCount(i)
+	return qjc
+}
+
+// SetNillableDeadCount sets the "deadCount" field if the given value is not nil.
+func (qjc *QueueJobCreate) SetNillableDeadCount(i *int) *QueueJobCreate {
+	if i != nil {
+		qjc.SetDeadCount(*i)
+	}
+	return qjc
+}
+
+// SetDeadReason sets the "deadReason" field.
+func (qjc *QueueJobCreate) SetDeadReason(s string) *QueueJobCreate {
+	qjc.mutation.SetDeadReason(s)
+	return qjc
+}
+
+// SetNillableDeadReason sets the "deadReason" field if the given value is not nil.
+func (qjc *QueueJobCreate) SetNillableDeadReason(s *string) *QueueJobCreate {
+	if s != nil {
+		qjc.SetDeadReason(*s)
+	}
+	return qjc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qjc *QueueJobCreate) SetCreatedAt(t time.Time) *QueueJobCreate {
+	qjc.mutation.SetCreatedAt(t)
+	return qjc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qjc *QueueJobCreate) SetNillableCreatedAt(t *time.Time) *QueueJobCreate {
+	if t != nil {
+		qjc.SetCreatedAt(*t)
+	}
+	return qjc
+}
+
+// Mutation returns the QueueJobMutation object of the builder.
+func (qjc *QueueJobCreate) Mutation() *QueueJobMutation {
+	return qjc.mutation
+}
+
+// Save creates the QueueJob in the database.
+func (qjc *QueueJobCreate) Save(ctx context.Context) (*QueueJob, error) {
+	var (
+		err  error
+		node *QueueJob
+	)
+	qjc.defaults()
+	if len(qjc.hooks) == 0 {
+		if err = qjc.check(); err != nil {
+			return nil, err
+		}
+		node, err = qjc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QueueJobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = qjc.check(); err != nil {
+				return nil, err
+			}
+			qjc.mutation = mutation
+			if node, err = qjc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(qjc.hooks) - 1; i >= 0; i-- {
+			if qjc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = qjc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, qjc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*QueueJob)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from QueueJobMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qjc *QueueJobCreate) SaveX(ctx context.Context) *QueueJob {
+	v, err := qjc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qjc *QueueJobCreate) Exec(ctx context.Context) error {
+	_, err := qjc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qjc *QueueJobCreate) ExecX(ctx context.Context) {
+	if err := qjc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qjc *QueueJobCreate) defaults() {
+	if _, ok := qjc.mutation.Deliveries(); !ok {
+		v := queuejob.DefaultDeliveries
+		qjc.mutation.SetDeliveries(v)
+	}
+	if _, ok := qjc.mutation.DeadCount(); !ok {
+		v := queuejob.DefaultDeadCount
+		qjc.mutation.SetDeadCount(v)
+	}
+	if _, ok := qjc.mutation.CreatedAt(); !ok {
+		v := queuejob.DefaultCreatedAt()
+		qjc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qjc *QueueJobCreate) check() error {
+	if _, ok := qjc.mutation.Queue(); !ok {
+		return &ValidationError{Name: "queue", err: errors.New(`ent: missing required field "QueueJob.queue"`)}
+	}
+	if _, ok := qjc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "QueueJob.payload"`)}
+	}
+	if _, ok := qjc.mutation.VisibleAt(); !ok {
+		return &ValidationError{Name: "visibleAt", err: errors.New(`ent: missing required field "QueueJob.visibleAt"`)}
+	}
+	if _, ok := qjc.mutation.Deliveries(); !ok {
+		return &ValidationError{Name: "deliveries", err: errors.New(`ent: missing required field "QueueJob.deliveries"`)}
+	}
+	if _, ok := qjc.mutation.DeadCount(); !ok {
+		return &ValidationError{Name: "deadCount", err: errors.New(`ent: missing required field "QueueJob.deadCount"`)}
+	}
+	if _, ok := qjc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QueueJob.created_at"`)}
+	}
+	return nil
+}
+
+func (qjc *QueueJobCreate) sqlSave(ctx context.Context) (*QueueJob, error) {
+	_node, _spec := qjc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qjc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (qjc *QueueJobCreate) createSpec() (*QueueJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QueueJob{config: qjc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: queuejob.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuejob.FieldID,
+			},
+		}
+	)
+	if value, ok := qjc.mutation.Queue(); ok {
+		_spec.SetField(queuejob.FieldQueue, field.TypeString, value)
+		_node.Queue = value
+	}
+	if value, ok := qjc.mutation.Payload(); ok {
+		_spec.SetField(queuejob.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := qjc.mutation.VisibleAt(); ok {
+		_spec.SetField(queuejob.FieldVisibleAt, field.TypeTime, value)
+		_node.VisibleAt = value
+	}
+	if value, ok := qjc.mutation.Deliveries(); ok {
+		_spec.SetField(queuejob.FieldDeliveries, field.TypeInt, value)
+		_node.Deliveries = value
+	}
+	if value, ok := qjc.mutation.DeadAt(); ok {
+		_spec.SetField(queuejob.FieldDeadAt, field.TypeTime, value)
+		_node.DeadAt = &value
+	}
+	if value, ok := qjc.mutation.DeadCount(); ok {
+		_spec.SetField(queuejob.FieldDeadCount, field.TypeInt, value)
+		_node.DeadCount = value
+	}
+	if value, ok := qjc.mutation.DeadReason(); ok {
+		_spec.SetField(queuejob.FieldDeadReason, field.TypeString, value)
+		_node.DeadReason = value
+	}
+	if value, ok := qjc.mutation.CreatedAt(); ok {
+		_spec.SetField(queuejob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// QueueJobCreateBulk is the builder for creating many QueueJob entities in bulk.
+type QueueJobCreateBulk struct {
+	config
+	builders []*QueueJobCreate
+}
+
+// Save creates the QueueJob entities in the database.
+func (qjcb *QueueJobCreateBulk) Save(ctx context.Context) ([]*QueueJob, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(qjcb.builders))
+	nodes := make([]*QueueJob, len(qjcb.builders))
+	mutators := make([]Mutator, len(qjcb.builders))
+	for i := range qjcb.builders {
+		func(i int, root context.Context) {
+			builder := qjcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QueueJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qjcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qjcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qjcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qjcb *QueueJobCreateBulk) SaveX(ctx context.Context) []*QueueJob {
+	v, err := qjcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qjcb *QueueJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := qjcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qjcb *QueueJobCreateBulk) ExecX(ctx context.Context) {
+	if err := qjcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+)
+
+// QueueJobDelete is the builder for deleting a QueueJob entity.
+type QueueJobDelete struct {
+	config
+	hooks    []Hook
+	mutation *QueueJobMutation
+}
+
+// Where appends a list predicates to the QueueJobDelete builder.
+func (qjd *QueueJobDelete) Where(ps ...predicate.QueueJob) *QueueJobDelete {
+	qjd.mutation.Where(ps...)
+	return qjd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qjd *QueueJobDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(qjd.hooks) == 0 {
+		affected, err = qjd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QueueJobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			qjd.mutation = mutation
+			affected, err = qjd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(qjd.hooks) - 1; i >= 0; i-- {
+			if qjd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = qjd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, qjd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qjd *QueueJobDelete) ExecX(ctx context.Context) int {
+	n, err := qjd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qjd *QueueJobDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: queuejob.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuejob.FieldID,
+			},
+		},
+	}
+	if ps := qjd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qjd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// QueueJobDeleteOne is the builder for deleting a single QueueJob entity.
+type QueueJobDeleteOne struct {
+	qjd *QueueJobDelete
+}
+
+// Exec executes the deletion query.
+func (qjdo *QueueJobDeleteOne) Exec(ctx context.Context) error {
+	n, err := qjdo.qjd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{queuejob.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qjdo *QueueJobDeleteOne) ExecX(ctx context.Context) {
+	qjdo.qjd.ExecX(ctx)
+}
@@ -0,0 +1,585 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+)
+
+// QueueJobQuery is the builder for querying QueueJob entities.
+type QueueJobQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.QueueJob
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QueueJobQuery builder.
+func (qjq *QueueJobQuery) Where(ps ...predicate.QueueJob) *QueueJobQuery {
+	qjq.predicates = append(qjq.predicates, ps...)
+	return qjq
+}
+
+// Limit adds a limit step to the query.
+func (qjq *QueueJobQuery) Limit(limit int) *QueueJobQuery {
+	qjq.limit = &limit
+	return qjq
+}
+
+// Offset adds an offset step to the query.
+func (qjq *QueueJobQuery) Offset(offset int) *QueueJobQuery {
+	qjq.offset = &offset
+	return qjq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qjq *QueueJobQuery) Unique(unique bool) *QueueJobQuery {
+	qjq.unique = &unique
+	return qjq
+}
+
+// Order adds an order step to the query.
+func (qjq *QueueJobQuery) Order(o ...OrderFunc) *QueueJobQuery {
+	qjq.order = append(qjq.order, o...)
+	return qjq
+}
+
+// First returns the first QueueJob entity from the query.
+// Returns a *NotFoundError when no QueueJob was found.
+func (qjq *QueueJobQuery) First(ctx context.Context) (*QueueJob, error) {
+	nodes, err := qjq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{queuejob.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qjq *QueueJobQuery) FirstX(ctx context.Context) *QueueJob {
+	node, err := qjq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QueueJob ID from the query.
+// Returns a *NotFoundError when no QueueJob ID was found.
+func (qjq *QueueJobQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qjq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{queuejob.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qjq *QueueJobQuery) FirstIDX(ctx context.Context) int {
+	id, err := qjq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QueueJob entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QueueJob entity is found.
+// Returns a *NotFoundError when no QueueJob entities are found.
+func (qjq *QueueJobQuery) Only(ctx context.Context) (*QueueJob, error) {
+	nodes, err := qjq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{queuejob.Label}
+	default:
+		return nil, &NotSingularError{queuejob.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qjq *QueueJobQuery) OnlyX(ctx context.Context) *QueueJob {
+	node, err := qjq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QueueJob ID in the query.
+// Returns a *NotSingularError when more than one QueueJob ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qjq *QueueJobQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qjq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{queuejob.Label}
+	default:
+		err = &NotSingularError{queuejob.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qjq *QueueJobQuery) OnlyIDX(ctx context.Context) int {
+	id, err := qjq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QueueJobs.
+func (qjq *QueueJobQuery) All(ctx context.Context) ([]*QueueJob, error) {
+	if err := qjq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return qjq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qjq *QueueJobQuery) AllX(ctx context.Context) []*QueueJob {
+	nodes, err := qjq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QueueJob IDs.
+func (qjq *QueueJobQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := qjq.Select(queuejob.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qjq *QueueJobQuery) IDsX(ctx context.Context) []int {
+	ids, err := qjq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qjq *QueueJobQuery) Count(ctx context.Context) (int, error) {
+	if err := qjq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return qjq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qjq *QueueJobQuery) CountX(ctx context.Context) int {
+	count, err := qjq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qjq *QueueJobQuery) Exist(ctx context.Context) (bool, error) {
+	if err := qjq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return qjq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qjq *QueueJobQuery) ExistX(ctx context.Context) bool {
+	exist, err := qjq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QueueJobQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qjq *QueueJobQuery) Clone() *QueueJobQuery {
+	if qjq == nil {
+		return nil
+	}
+	return &QueueJobQuery{
+		config:     qjq.config,
+		limit:      qjq.limit,
+		offset:     qjq.offset,
+		order:      append([]OrderFunc{}, qjq.order...),
+		predicates: append([]predicate.QueueJob{}, qjq.predicates...),
+		// clone intermediate query.
+		sql:    qjq.sql.Clone(),
+		path:   qjq.path,
+		unique: qjq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Queue string `json:"queue,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QueueJob.Query().
+//		GroupBy(queuejob.FieldQueue).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qjq *QueueJobQuery) GroupBy(field string, fields ...string) *QueueJobGroupBy {
+	grbuild := &QueueJobGroupBy{config: qjq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := qjq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return qjq.sqlQuery(ctx), nil
+	}
+	grbuild.label = queuejob.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Queue string `json:"queue,omitempty"`
+//	}
+//
+//	client.QueueJob.Query().
+//		Select(queuejob.FieldQueue).
+//		Scan(ctx, &v)
+func (qjq *QueueJobQuery) Select(fields ...string) *QueueJobSelect {
+	qjq.fields = append(qjq.fields, fields...)
+	selbuild := &QueueJobSelect{QueueJobQuery: qjq}
+	selbuild.label = queuejob.Label
+	selbuild.flds, selbuild.scan = &qjq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a QueueJobSelect configured with the given aggregations.
+func (qjq *QueueJobQuery) Aggregate(fns ...AggregateFunc) *QueueJobSelect {
+	return qjq.Select().Aggregate(fns...)
+}
+
+func (qjq *QueueJobQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range qjq.fields {
+		if !queuejob.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qjq.path != nil {
+		prev, err := qjq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qjq.sql = prev
+	}
+	return nil
+}
+
+func (qjq *QueueJobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QueueJob, error) {
+	var (
+		nodes = []*QueueJob{}
+		_spec = qjq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QueueJob).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QueueJob{config: qjq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(qjq.modifiers) > 0 {
+		_spec.Modifiers = qjq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qjq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (qjq *QueueJobQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qjq.querySpec()
+	if len(qjq.modifiers) > 0 {
+		_spec.Modifiers = qjq.modifiers
+	}
+	_spec.Node.Columns = qjq.fields
+	if len(qjq.fields) > 0 {
+		_spec.Unique = qjq.unique != nil && *qjq.unique
+	}
+	return sqlgraph.CountNodes(ctx, qjq.driver, _spec)
+}
+
+func (qjq *QueueJobQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := qjq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (qjq *QueueJobQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   queuejob.Table,
+			Columns: queuejob.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuejob.FieldID,
+			},
+		},
+		From:   qjq.sql,
+		Unique: true,
+	}
+	if unique := qjq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := qjq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, queuejob.FieldID)
+		for i := range fields {
+			if fields[i] != queuejob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := qjq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qjq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qjq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qjq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qjq *QueueJobQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qjq.driver.Dialect())
+	t1 := builder.Table(queuejob.Table)
+	columns := qjq.fields
+	if len(columns) == 0 {
+		columns = queuejob.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qjq.sql != nil {
+		selector = qjq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qjq.unique != nil && *qjq.unique {
+		selector.Distinct()
+	}
+	for _, m := range qjq.modifiers {
+		m(selector)
+	}
+	for _, p := range qjq.predicates {
+		p(selector)
+	}
+	for _, p := range qjq.order {
+		p(selector)
+	}
+	if offset := qjq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qjq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (qjq *QueueJobQuery) ForUpdate(opts ...sql.LockOption) *QueueJobQuery {
+	if qjq.driver.Dialect() == dialect.Postgres {
+		qjq.Unique(false)
+	}
+	qjq.modifiers = append(qjq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return qjq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (qjq *QueueJobQuery) ForShare(opts ...sql.LockOption) *QueueJobQuery {
+	if qjq.driver.Dialect() == dialect.Postgres {
+		qjq.Unique(false)
+	}
+	qjq.modifiers = append(qjq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return qjq
+}
+
+// QueueJobGroupBy is the group-by builder for QueueJob entities.
+type QueueJobGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qjgb *QueueJobGroupBy) Aggregate(fns ...AggregateFunc) *QueueJobGroupBy {
+	qjgb.fns = append(qjgb.fns, fns...)
+	return qjgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (qjgb *QueueJobGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := qjgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	qjgb.sql = query
+	return qjgb.sqlScan(ctx, v)
+}
+
+func (qjgb *QueueJobGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range qjgb.fields {
+		if !queuejob.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := qjgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qjgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (qjgb *QueueJobGroupBy) sqlQuery() *sql.Selector {
+	selector := qjgb.sql.Select()
+	aggregation := make([]string, 0, len(qjgb.fns))
+	for _, fn := range qjgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(qjgb.fields)+len(qjgb.fns))
+		for _, f := range qjgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(qjgb.fields...)...)
+}
+
+// QueueJobSelect is the builder for selecting fields of QueueJob entities.
+type QueueJobSelect struct {
+	*QueueJobQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qjs *QueueJobSelect) Aggregate(fns ...AggregateFunc) *QueueJobSelect {
+	qjs.fns = append(qjs.fns, fns...)
+	return qjs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qjs *QueueJobSelect) Scan(ctx context.Context, v any) error {
+	if err := qjs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qjs.sql = qjs.QueueJobQuery.sqlQuery(ctx)
+	return qjs.sqlScan(ctx, v)
+}
+
+func (qjs *QueueJobSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(qjs.fns))
+	for _, fn := range qjs.fns {
+		aggregation = append(aggregation, fn(qjs.sql))
+	}
+	switch n := len(*qjs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		qjs.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		qjs.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := qjs.sql.Query()
+	if err := qjs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
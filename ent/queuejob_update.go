@@ -0,0 +1,546 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+)
+
+// QueueJobUpdate is the builder for updating QueueJob entities.
+type QueueJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QueueJobMutation
+}
+
+// Where appends a list predicates to the QueueJobUpdate builder.
+func (qju *QueueJobUpdate) Where(ps ...predicate.QueueJob) *QueueJobUpdate {
+	qju.mutation.Where(ps...)
+	return qju
+}
+
+// SetQueue sets the "queue" field.
+func (qju *QueueJobUpdate) SetQueue(s string) *QueueJobUpdate {
+	qju.mutation.SetQueue(s)
+	return qju
+}
+
+// SetPayload sets the "payload" field.
+func (qju *QueueJobUpdate) SetPayload(b []byte) *QueueJobUpdate {
+	qju.mutation.SetPayload(b)
+	return qju
+}
+
+// SetVisibleAt sets the "visibleAt" field.
+func (qju *QueueJobUpdate) SetVisibleAt(t time.Time) *QueueJobUpdate {
+	qju.mutation.SetVisibleAt(t)
+	return qju
+}
+
+// SetDeliveries sets the "deliveries" field.
+func (qju *QueueJobUpdate) SetDeliveries(i int) *QueueJobUpdate {
+	qju.mutation.ResetDeliveries()
+	qju.mutation.SetDeliveries(i)
+	return qju
+}
+
+// SetNillableDeliveries sets the "deliveries" field if the given value is not nil.
+func (qju *QueueJobUpdate) SetNillableDeliveries(i *int) *QueueJobUpdate {
+	if i != nil {
+		qju.SetDeliveries(*i)
+	}
+	return qju
+}
+
+// AddDeliveries adds i to the "deliveries" field.
+func (qju *QueueJobUpdate) AddDeliveries(i int) *QueueJobUpdate {
+	qju.mutation.AddDeliveries(i)
+	return qju
+}
+
+// SetDeadAt sets the "deadAt" field.
+func (qju *QueueJobUpdate) SetDeadAt(t time.Time) *QueueJobUpdate {
+	qju.mutation.SetDeadAt(t)
+	return qju
+}
+
+// SetNillableDeadAt sets the "deadAt" field if the given value is not nil.
+func (qju *QueueJobUpdate) SetNillableDeadAt(t *time.Time) *QueueJobUpdate {
+	if t != nil {
+		qju.SetDeadAt(*t)
+	}
+	return qju
+}
+
+// ClearDeadAt clears the value of the "deadAt" field.
+func (qju *QueueJobUpdate) ClearDeadAt() *QueueJobUpdate {
+	qju.mutation.ClearDeadAt()
+	return qju
+}
+
+// SetDeadCount sets the "deadCount" field.
+func (qju *QueueJobUpdate) SetDeadCount(i int) *QueueJobUpdate {
+	qju.mutation.ResetDeadCount()
+	qju.mutation.SetDeadCount(i)
+	return qju
+}
+
+// SetNillableDeadCount sets the "deadCount" field if the given value is not nil.
+func (qju *QueueJobUpdate) SetNillableDeadCount(i *int) *QueueJobUpdate {
+	if i != nil {
+		qju.SetDeadCount(*i)
+	}
+	return qju
+}
+
+// AddDeadCount adds i to the "deadCount" field.
+func (qju *QueueJobUpdate) AddDeadCount(i int) *QueueJobUpdate {
+	qju.mutation.AddDeadCount(i)
+	return qju
+}
+
+// SetDeadReason sets the "deadReason" field.
+func (qju *QueueJobUpdate) SetDeadReason(s string) *QueueJobUpdate {
+	qju.mutation.SetDeadReason(s)
+	return qju
+}
+
+// SetNillableDeadReason sets the "deadReason" field if the given value is not nil.
+func (qju *QueueJobUpdate) SetNillableDeadReason(s *string) *QueueJobUpdate {
+	if s != nil {
+		qju.SetDeadReason(*s)
+	}
+	return qju
+}
+
+// ClearDeadReason clears the value of the "deadReason" field.
+func (qju *QueueJobUpdate) ClearDeadReason() *QueueJobUpdate {
+	qju.mutation.ClearDeadReason()
+	return qju
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qju *QueueJobUpdate) SetCreatedAt(t time.Time) *QueueJobUpdate {
+	qju.mutation.SetCreatedAt(t)
+	return qju
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qju *QueueJobUpdate) SetNillableCreatedAt(t *time.Time) *QueueJobUpdate {
+	if t != nil {
+		qju.SetCreatedAt(*t)
+	}
+	return qju
+}
+
+// Mutation returns the QueueJobMutation object of the builder.
+func (qju *QueueJobUpdate) Mutation() *QueueJobMutation {
+	return qju.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qju *QueueJobUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(qju.hooks) == 0 {
+		affected, err = qju.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QueueJobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			qju.mutation = mutation
+			affected, err = qju.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(qju.hooks) - 1; i >= 0; i-- {
+			if qju.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = qju.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, qju.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qju *QueueJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := qju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qju *QueueJobUpdate) Exec(ctx context.Context) error {
+	_, err := qju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qju *QueueJobUpdate) ExecX(ctx context.Context) {
+	if err := qju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (qju *QueueJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   queuejob.Table,
+			Columns: queuejob.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuejob.FieldID,
+			},
+		},
+	}
+	if ps := qju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qju.mutation.Queue(); ok {
+		_spec.SetField(queuejob.FieldQueue, field.TypeString, value)
+	}
+	if value, ok := qju.mutation.Payload(); ok {
+		_spec.SetField(queuejob.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := qju.mutation.VisibleAt(); ok {
+		_spec.SetField(queuejob.FieldVisibleAt, field.TypeTime, value)
+	}
+	if value, ok := qju.mutation.Deliveries(); ok {
+		_spec.SetField(queuejob.FieldDeliveries, field.TypeInt, value)
+	}
+	if value, ok := qju.mutation.AddedDeliveries(); ok {
+		_spec.AddField(queuejob.FieldDeliveries, field.TypeInt, value)
+	}
+	if value, ok := qju.mutation.DeadAt(); ok {
+		_spec.SetField(queuejob.FieldDeadAt, field.TypeTime, value)
+	}
+	if qju.mutation.DeadAtCleared() {
+		_spec.ClearField(queuejob.FieldDeadAt, field.TypeTime)
+	}
+	if value, ok := qju.mutation.DeadCount(); ok {
+		_spec.SetField(queuejob.FieldDeadCount, field.TypeInt, value)
+	}
+	if value, ok := qju.mutation.AddedDeadCount(); ok {
+		_spec.AddField(queuejob.FieldDeadCount, field.TypeInt, value)
+	}
+	if value, ok := qju.mutation.DeadReason(); ok {
+		_spec.SetField(queuejob.FieldDeadReason, field.TypeString, value)
+	}
+	if qju.mutation.DeadReasonCleared() {
+		_spec.ClearField(queuejob.FieldDeadReason, field.TypeString)
+	}
+	if value, ok := qju.mutation.CreatedAt(); ok {
+		_spec.SetField(queuejob.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// QueueJobUpdateOne is the builder for updating a single QueueJob entity.
+type QueueJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QueueJobMutation
+}
+
+// SetQueue sets the "queue" field.
+func (qjuo *QueueJobUpdateOne) SetQueue(s string) *QueueJobUpdateOne {
+	qjuo.mutation.SetQueue(s)
+	return qjuo
+}
+
+// SetPayload sets the "payload" field.
+func (qjuo *QueueJobUpdateOne) SetPayload(b []byte) *QueueJobUpdateOne {
+	qjuo.mutation.SetPayload(b)
+	return qjuo
+}
+
+// SetVisibleAt sets the "visibleAt" field.
+func (qjuo *QueueJobUpdateOne) SetVisibleAt(t time.Time) *QueueJobUpdateOne {
+	qjuo.mutation.SetVisibleAt(t)
+	return qjuo
+}
+
+// SetDeliveries sets the "deliveries" field.
+func (qjuo *QueueJobUpdateOne) SetDeliveries(i int) *QueueJobUpdateOne {
+	qjuo.mutation.ResetDeliveries()
+	qjuo.mutation.SetDeliveries(i)
+	return qjuo
+}
+
+// SetNillableDeliveries sets the "deliveries" field if the given value is not nil.
+func (qjuo *QueueJobUpdateOne) SetNillableDeliveries(i *int) *QueueJobUpdateOne {
+	if i != nil {
+		qjuo.SetDeliveries(*i)
+	}
+	return qjuo
+}
+
+// AddDeliveries adds i to the "deliveries" field.
+func (qjuo *QueueJobUpdateOne) AddDeliveries(i int) *QueueJobUpdateOne {
+	qjuo.mutation.AddDeliveries(i)
+	return qjuo
+}
+
+// SetDeadAt sets the "deadAt" field.
+func (qjuo *QueueJobUpdateOne) SetDeadAt(t time.Time) *QueueJobUpdateOne {
+	qjuo.mutation.SetDeadAt(t)
+	return qjuo
+}
+
+// SetNillableDeadAt sets the "deadAt" field if the given value is not nil.
+func (qjuo *QueueJobUpdateOne) SetNillableDeadAt(t *time.Time) *QueueJobUpdateOne {
+	if t != nil {
+		qjuo.SetDeadAt(*t)
+	}
+	return qjuo
+}
+
+// ClearDeadAt clears the value of the "deadAt" field.
+func (qjuo *QueueJobUpdateOne) ClearDeadAt() *QueueJobUpdateOne {
+	qjuo.mutation.ClearDeadAt()
+	return qjuo
+}
+
+// SetDeadCount sets the "deadCount" field.
+func (qjuo *QueueJobUpdateOne) SetDeadCount(i int) *QueueJobUpdateOne {
+	qjuo.mutation.ResetDeadCount()
+	qjuo.mutation.SetDeadCount(i)
+	return qjuo
+}
+
+// SetNillableDeadCount sets the "deadCount" field if the given value is not nil.
+func (qjuo *QueueJobUpdateOne) SetNillableDeadCount(i *int) *QueueJobUpdateOne {
+	if i != nil {
+		qjuo.SetDeadCount(*i)
+	}
+	return qjuo
+}
+
+// AddDeadCount adds i to the "deadCount" field.
+func (qjuo *QueueJobUpdateOne) AddDeadCount(i int) *QueueJobUpdateOne {
+	qjuo.mutation.AddDeadCount(i)
+	return qjuo
+}
+
+// SetDeadReason sets the "deadReason" field.
+func (qjuo *QueueJobUpdateOne) SetDeadReason(s string) *QueueJobUpdateOne {
+	qjuo.mutation.SetDeadReason(s)
+	return qjuo
+}
+
+// SetNillableDeadReason sets the "deadReason" field if the given value is not nil.
+func (qjuo *QueueJobUpdateOne) SetNillableDeadReason(s *string) *QueueJobUpdateOne {
+	if s != nil {
+		qjuo.SetDeadReason(*s)
+	}
+	return qjuo
+}
+
+// ClearDeadReason clears the value of the "deadReason" field.
+func (qjuo *QueueJobUpdateOne) ClearDeadReason() *QueueJobUpdateOne {
+	qjuo.mutation.ClearDeadReason()
+	return qjuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qjuo *QueueJobUpdateOne) SetCreatedAt(t time.Time) *QueueJobUpdateOne {
+	qjuo.mutation.SetCreatedAt(t)
+	return qjuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qjuo *QueueJobUpdateOne) SetNillableCreatedAt(t *time.Time) *QueueJobUpdateOne {
+	if t != nil {
+		qjuo.SetCreatedAt(*t)
+	}
+	return qjuo
+}
+
+// Mutation returns the QueueJobMutation object of the builder.
+func (qjuo *QueueJobUpdateOne) Mutation() *QueueJobMutation {
+	return qjuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qjuo *QueueJobUpdateOne) Select(field string, fields ...string) *QueueJobUpdateOne {
+	qjuo.fields = append([]string{field}, fields...)
+	return qjuo
+}
+
+// Save executes the query and returns the updated QueueJob entity.
+func (qjuo *QueueJobUpdateOne) Save(ctx context.Context) (*QueueJob, error) {
+	var (
+		err  error
+		node *QueueJob
+	)
+	if len(qjuo.hooks) == 0 {
+		node, err = qjuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QueueJobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			qjuo.mutation = mutation
+			node, err = qjuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(qjuo.hooks) - 1; i >= 0; i-- {
+			if qjuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = qjuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, qjuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*QueueJob)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from QueueJobMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qjuo *QueueJobUpdateOne) SaveX(ctx context.Context) *QueueJob {
+	node, err := qjuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qjuo *QueueJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := qjuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qjuo *QueueJobUpdateOne) ExecX(ctx context.Context) {
+	if err := qjuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (qjuo *QueueJobUpdateOne) sqlSave(ctx context.Context) (_node *QueueJob, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   queuejob.Table,
+			Columns: queuejob.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuejob.FieldID,
+			},
+		},
+	}
+	id, ok := qjuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QueueJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qjuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, queuejob.FieldID)
+		for _, f := range fields {
+			if !queuejob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != queuejob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qjuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qjuo.mutation.Queue(); ok {
+		_spec.SetField(queuejob.FieldQueue, field.TypeString, value)
+	}
+	if value, ok := qjuo.mutation.Payload(); ok {
+		_spec.SetField(queuejob.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := qjuo.mutation.VisibleAt(); ok {
+		_spec.SetField(queuejob.FieldVisibleAt, field.TypeTime, value)
+	}
+	if value, ok := qjuo.mutation.Deliveries(); ok {
+		_spec.SetField(queuejob.FieldDeliveries, field.TypeInt, value)
+	}
+	if value, ok := qjuo.mutation.AddedDeliveries(); ok {
+		_spec.AddField(queuejob.FieldDeliveries, field.TypeInt, value)
+	}
+	if value, ok := qjuo.mutation.DeadAt(); ok {
+		_spec.SetField(queuejob.FieldDeadAt, field.TypeTime, value)
+	}
+	if qjuo.mutation.DeadAtCleared() {
+		_spec.ClearField(queuejob.FieldDeadAt, field.TypeTime)
+	}
+	if value, ok := qjuo.mutation.DeadCount(); ok {
+		_spec.SetField(queuejob.FieldDeadCount, field.TypeInt, value)
+	}
+	if value, ok := qjuo.mutation.AddedDeadCount(); ok {
+		_spec.AddField(queuejob.FieldDeadCount, field.TypeInt, value)
+	}
+	if value, ok := qjuo.mutation.DeadReason(); ok {
+		_spec.SetField(queuejob.FieldDeadReason, field.TypeString, value)
+	}
+	if qjuo.mutation.DeadReasonCleared() {
+		_spec.ClearField(queuejob.FieldDeadReason, field.TypeString)
+	}
+	if value, ok := qjuo.mutation.CreatedAt(); ok {
+		_spec.SetField(queuejob.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &QueueJob{config: qjuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qjuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
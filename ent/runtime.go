@@ -3,36 +3,110 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/Av1shay/timers-scheduler-demo/ent/outboxmessage"
+	"github.com/Av1shay/timers-scheduler-demo/ent/queuejob"
+	"github.com/Av1shay/timers-scheduler-demo/ent/schedulerinstance"
 	"github.com/Av1shay/timers-scheduler-demo/ent/schema"
 	"github.com/Av1shay/timers-scheduler-demo/ent/task"
 	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
-	"time"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	outboxmessageFields := schema.OutboxMessage{}.Fields()
+	_ = outboxmessageFields
+	// outboxmessageDescAttempts is the schema descriptor for attempts field.
+	outboxmessageDescAttempts := outboxmessageFields[4].Descriptor()
+	// outboxmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxmessage.DefaultAttempts = outboxmessageDescAttempts.Default.(int)
+	// outboxmessageDescCreatedAt is the schema descriptor for created_at field.
+	outboxmessageDescCreatedAt := outboxmessageFields[6].Descriptor()
+	// outboxmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxmessage.DefaultCreatedAt = outboxmessageDescCreatedAt.Default.(func() time.Time)
+	queuejobFields := schema.QueueJob{}.Fields()
+	_ = queuejobFields
+	// queuejobDescDeliveries is the schema descriptor for deliveries field.
+	queuejobDescDeliveries := queuejobFields[3].Descriptor()
+	// queuejob.DefaultDeliveries holds the default value on creation for the deliveries field.
+	queuejob.DefaultDeliveries = queuejobDescDeliveries.Default.(int)
+	// queuejobDescDeadCount is the schema descriptor for deadCount field.
+	queuejobDescDeadCount := queuejobFields[5].Descriptor()
+	// queuejob.DefaultDeadCount holds the default value on creation for the deadCount field.
+	queuejob.DefaultDeadCount = queuejobDescDeadCount.Default.(int)
+	// queuejobDescCreatedAt is the schema descriptor for created_at field.
+	queuejobDescCreatedAt := queuejobFields[7].Descriptor()
+	// queuejob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	queuejob.DefaultCreatedAt = queuejobDescCreatedAt.Default.(func() time.Time)
+	schedulerinstanceFields := schema.SchedulerInstance{}.Fields()
+	_ = schedulerinstanceFields
+	// schedulerinstanceDescCreatedAt is the schema descriptor for created_at field.
+	schedulerinstanceDescCreatedAt := schedulerinstanceFields[2].Descriptor()
+	// schedulerinstance.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schedulerinstance.DefaultCreatedAt = schedulerinstanceDescCreatedAt.Default.(func() time.Time)
 	taskFields := schema.Task{}.Fields()
 	_ = taskFields
+	// taskDescWebhookHost is the schema descriptor for webhookHost field.
+	taskDescWebhookHost := taskFields[2].Descriptor()
+	// task.DefaultWebhookHost holds the default value on creation for the webhookHost field.
+	task.DefaultWebhookHost = taskDescWebhookHost.Default.(string)
+	// taskDescMethod is the schema descriptor for method field.
+	taskDescMethod := taskFields[3].Descriptor()
+	// task.DefaultMethod holds the default value on creation for the method field.
+	task.DefaultMethod = taskDescMethod.Default.(string)
+	// taskDescOmitIdSuffix is the schema descriptor for omitIdSuffix field.
+	taskDescOmitIdSuffix := taskFields[6].Descriptor()
+	// task.DefaultOmitIdSuffix holds the default value on creation for the omitIdSuffix field.
+	task.DefaultOmitIdSuffix = taskDescOmitIdSuffix.Default.(bool)
+	// taskDescAttempts is the schema descriptor for attempts field.
+	taskDescAttempts := taskFields[9].Descriptor()
+	// task.DefaultAttempts holds the default value on creation for the attempts field.
+	task.DefaultAttempts = taskDescAttempts.Default.(int)
+	// taskDescReclaims is the schema descriptor for reclaims field.
+	taskDescReclaims := taskFields[12].Descriptor()
+	// task.DefaultReclaims holds the default value on creation for the reclaims field.
+	task.DefaultReclaims = taskDescReclaims.Default.(int)
+	// taskDescShard is the schema descriptor for shard field.
+	taskDescShard := taskFields[13].Descriptor()
+	// task.DefaultShard holds the default value on creation for the shard field.
+	task.DefaultShard = taskDescShard.Default.(int)
+	// taskDescCaller is the schema descriptor for caller field.
+	taskDescCaller := taskFields[18].Descriptor()
+	// task.DefaultCaller holds the default value on creation for the caller field.
+	task.DefaultCaller = taskDescCaller.Default.(string)
 	// taskDescCreatedAt is the schema descriptor for created_at field.
-	taskDescCreatedAt := taskFields[3].Descriptor()
+	taskDescCreatedAt := taskFields[21].Descriptor()
 	// task.DefaultCreatedAt holds the default value on creation for the created_at field.
 	task.DefaultCreatedAt = taskDescCreatedAt.Default.(func() time.Time)
 	// taskDescUpdatedAt is the schema descriptor for updated_at field.
-	taskDescUpdatedAt := taskFields[4].Descriptor()
+	taskDescUpdatedAt := taskFields[22].Descriptor()
 	// task.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
 	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	task.UpdateDefaultUpdatedAt = taskDescUpdatedAt.UpdateDefault.(func() time.Time)
 	taskhistoryFields := schema.TaskHistory{}.Fields()
 	_ = taskhistoryFields
+	// taskhistoryDescRunAt is the schema descriptor for runAt field.
+	taskhistoryDescRunAt := taskhistoryFields[2].Descriptor()
+	// taskhistory.DefaultRunAt holds the default value on creation for the runAt field.
+	taskhistory.DefaultRunAt = taskhistoryDescRunAt.Default.(func() time.Time)
+	// taskhistoryDescAttempt is the schema descriptor for attempt field.
+	taskhistoryDescAttempt := taskhistoryFields[3].Descriptor()
+	// taskhistory.DefaultAttempt holds the default value on creation for the attempt field.
+	taskhistory.DefaultAttempt = taskhistoryDescAttempt.Default.(int)
+	// taskhistoryDescLatencyMs is the schema descriptor for latencyMs field.
+	taskhistoryDescLatencyMs := taskhistoryFields[5].Descriptor()
+	// taskhistory.DefaultLatencyMs holds the default value on creation for the latencyMs field.
+	taskhistory.DefaultLatencyMs = taskhistoryDescLatencyMs.Default.(int64)
 	// taskhistoryDescCreatedAt is the schema descriptor for created_at field.
-	taskhistoryDescCreatedAt := taskhistoryFields[1].Descriptor()
+	taskhistoryDescCreatedAt := taskhistoryFields[6].Descriptor()
 	// taskhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
 	taskhistory.DefaultCreatedAt = taskhistoryDescCreatedAt.Default.(func() time.Time)
 	// taskhistoryDescUpdatedAt is the schema descriptor for updated_at field.
-	taskhistoryDescUpdatedAt := taskhistoryFields[2].Descriptor()
+	taskhistoryDescUpdatedAt := taskhistoryFields[7].Descriptor()
 	// taskhistory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	taskhistory.DefaultUpdatedAt = taskhistoryDescUpdatedAt.Default.(func() time.Time)
 	// taskhistory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
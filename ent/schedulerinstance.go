@@ -0,0 +1,111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Av1shay/timers-scheduler-demo/ent/schedulerinstance"
+)
+
+// SchedulerInstance is the model entity for the SchedulerInstance schema.
+type SchedulerInstance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// HeartbeatAt holds the value of the "heartbeatAt" field.
+	HeartbeatAt time.Time `json:"heartbeatAt,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SchedulerInstance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedulerinstance.FieldID:
+			values[i] = new(sql.NullString)
+		case schedulerinstance.FieldHeartbeatAt, schedulerinstance.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SchedulerInstance", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SchedulerInstance fields.
+func (si *SchedulerInstance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedulerinstance.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				si.ID = value.String
+			}
+		case schedulerinstance.FieldHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field heartbeatAt", values[i])
+			} else if value.Valid {
+				si.HeartbeatAt = value.Time
+			}
+		case schedulerinstance.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				si.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this SchedulerInstance.
+// Note that you need to call SchedulerInstance.Unwrap() before calling this method if this SchedulerInstance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (si *SchedulerInstance) Update() *SchedulerInstanceUpdateOne {
+	return (&SchedulerInstanceClient{config: si.config}).UpdateOne(si)
+}
+
+// Unwrap unwraps the SchedulerInstance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (si *SchedulerInstance) Unwrap() *SchedulerInstance {
+	_tx, ok := si.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SchedulerInstance is not a transactional entity")
+	}
+	si.config.driver = _tx.drv
+	return si
+}
+
+// String implements the fmt.Stringer.
+func (si *SchedulerInstance) String() string {
+	var builder strings.Builder
+	builder.WriteString("SchedulerInstance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", si.ID))
+	builder.WriteString("heartbeatAt=")
+	builder.WriteString(si.HeartbeatAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(si.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SchedulerInstances is a parsable slice of SchedulerInstance.
+type SchedulerInstances []*SchedulerInstance
+
+func (si SchedulerInstances) config(cfg config) {
+	for _i := range si {
+		si[_i].config = cfg
+	}
+}
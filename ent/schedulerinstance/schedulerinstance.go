@@ -0,0 +1,42 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schedulerinstance
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the schedulerinstance type in the database.
+	Label = "scheduler_instance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHeartbeatAt holds the string denoting the heartbeatat field in the database.
+	FieldHeartbeatAt = "heartbeat_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the schedulerinstance in the database.
+	Table = "scheduler_instances"
+)
+
+// Columns holds all SQL columns for schedulerinstance fields.
+var Columns = []string{
+	FieldID,
+	FieldHeartbeatAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
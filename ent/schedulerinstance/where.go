@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schedulerinstance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// HeartbeatAt applies equality check predicate on the "heartbeatAt" field. It's identical to HeartbeatAtEQ.
+func HeartbeatAt(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHeartbeatAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HeartbeatAtEQ applies the EQ predicate on the "heartbeatAt" field.
+func HeartbeatAtEQ(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHeartbeatAt), v))
+	})
+}
+
+// HeartbeatAtNEQ applies the NEQ predicate on the "heartbeatAt" field.
+func HeartbeatAtNEQ(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHeartbeatAt), v))
+	})
+}
+
+// HeartbeatAtIn applies the In predicate on the "heartbeatAt" field.
+func HeartbeatAtIn(vs ...time.Time) predicate.SchedulerInstance {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldHeartbeatAt), v...))
+	})
+}
+
+// HeartbeatAtNotIn applies the NotIn predicate on the "heartbeatAt" field.
+func HeartbeatAtNotIn(vs ...time.Time) predicate.SchedulerInstance {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldHeartbeatAt), v...))
+	})
+}
+
+// HeartbeatAtGT applies the GT predicate on the "heartbeatAt" field.
+func HeartbeatAtGT(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHeartbeatAt), v))
+	})
+}
+
+// HeartbeatAtGTE applies the GTE predicate on the "heartbeatAt" field.
+func HeartbeatAtGTE(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHeartbeatAt), v))
+	})
+}
+
+// HeartbeatAtLT applies the LT predicate on the "heartbeatAt" field.
+func HeartbeatAtLT(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHeartbeatAt), v))
+	})
+}
+
+// HeartbeatAtLTE applies the LTE predicate on the "heartbeatAt" field.
+func HeartbeatAtLTE(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHeartbeatAt), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SchedulerInstance {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SchedulerInstance {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SchedulerInstance) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SchedulerInstance) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SchedulerInstance) predicate.SchedulerInstance {
+	return predicate.SchedulerInstance(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
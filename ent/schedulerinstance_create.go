@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/schedulerinstance"
+)
+
+// SchedulerInstanceCreate is the builder for creating a SchedulerInstance entity.
+type SchedulerInstanceCreate struct {
+	config
+	mutation *SchedulerInstanceMutation
+	hooks    []Hook
+}
+
+// SetHeartbeatAt sets the "heartbeatAt" field.
+func (sic *SchedulerInstanceCreate) SetHeartbeatAt(t time.Time) *SchedulerInstanceCreate {
+	sic.mutation.SetHeartbeatAt(t)
+	return sic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sic *SchedulerInstanceCreate) SetCreatedAt(t time.Time) *SchedulerInstanceCreate {
+	sic.mutation.SetCreatedAt(t)
+	return sic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sic *SchedulerInstanceCreate) SetNillableCreatedAt(t *time.Time) *SchedulerInstanceCreate {
+	if t != nil {
+		sic.SetCreatedAt(*t)
+	}
+	return sic
+}
+
+// SetID sets the "id" field.
+func (sic *SchedulerInstanceCreate) SetID(s string) *SchedulerInstanceCreate {
+	sic.mutation.SetID(s)
+	return sic
+}
+
+// Mutation returns the SchedulerInstanceMutation object of the builder.
+func (sic *SchedulerInstanceCreate) Mutation() *SchedulerInstanceMutation {
+	return sic.mutation
+}
+
+// Save creates the SchedulerInstance in the database.
+func (sic *SchedulerInstanceCreate) Save(ctx context.Context) (*SchedulerInstance, error) {
+	var (
+		err  error
+		node *SchedulerInstance
+	)
+	sic.defaults()
+	if len(sic.hooks) == 0 {
+		if err = sic.check(); err != nil {
+			return nil, err
+		}
+		node, err = sic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SchedulerInstanceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sic.check(); err != nil {
+				return nil, err
+			}
+			sic.mutation = mutation
+			if node, err = sic.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sic.hooks) - 1; i >= 0; i-- {
+			if sic.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sic.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, sic.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SchedulerInstance)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SchedulerInstanceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sic *SchedulerInstanceCreate) SaveX(ctx context.Context) *SchedulerInstance {
+	v, err := sic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sic *SchedulerInstanceCreate) Exec(ctx context.Context) error {
+	_, err := sic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sic *SchedulerInstanceCreate) ExecX(ctx context.Context) {
+	if err := sic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sic *SchedulerInstanceCreate) defaults() {
+	if _, ok := sic.mutation.CreatedAt(); !ok {
+		v := schedulerinstance.DefaultCreatedAt()
+		sic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sic *SchedulerInstanceCreate) check() error {
+	if _, ok := sic.mutation.HeartbeatAt(); !ok {
+		return &ValidationError{Name: "heartbeatAt", err: errors.New(`ent: missing required field "SchedulerInstance.heartbeatAt"`)}
+	}
+	if _, ok := sic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SchedulerInstance.created_at"`)}
+	}
+	return nil
+}
+
+func (sic *SchedulerInstanceCreate) sqlSave(ctx context.Context) (*SchedulerInstance, error) {
+	_node, _spec := sic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SchedulerInstance.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (sic *SchedulerInstanceCreate) createSpec() (*SchedulerInstance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SchedulerInstance{config: sic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: schedulerinstance.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: schedulerinstance.FieldID,
+			},
+		}
+	)
+	if id, ok := sic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sic.mutation.HeartbeatAt(); ok {
+		_spec.SetField(schedulerinstance.FieldHeartbeatAt, field.TypeTime, value)
+		_node.HeartbeatAt = value
+	}
+	if value, ok := sic.mutation.CreatedAt(); ok {
+		_spec.SetField(schedulerinstance.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SchedulerInstanceCreateBulk is the builder for creating many SchedulerInstance entities in bulk.
+type SchedulerInstanceCreateBulk struct {
+	config
+	builders []*SchedulerInstanceCreate
+}
+
+// Save creates the SchedulerInstance entities in the database.
+func (sicb *SchedulerInstanceCreateBulk) Save(ctx context.Context) ([]*SchedulerInstance, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sicb.builders))
+	nodes := make([]*SchedulerInstance, len(sicb.builders))
+	mutators := make([]Mutator, len(sicb.builders))
+	for i := range sicb.builders {
+		func(i int, root context.Context) {
+			builder := sicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SchedulerInstanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sicb *SchedulerInstanceCreateBulk) SaveX(ctx context.Context) []*SchedulerInstance {
+	v, err := sicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sicb *SchedulerInstanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := sicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sicb *SchedulerInstanceCreateBulk) ExecX(ctx context.Context) {
+	if err := sicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/schedulerinstance"
+)
+
+// SchedulerInstanceDelete is the builder for deleting a SchedulerInstance entity.
+type SchedulerInstanceDelete struct {
+	config
+	hooks    []Hook
+	mutation *SchedulerInstanceMutation
+}
+
+// Where appends a list predicates to the SchedulerInstanceDelete builder.
+func (sid *SchedulerInstanceDelete) Where(ps ...predicate.SchedulerInstance) *SchedulerInstanceDelete {
+	sid.mutation.Where(ps...)
+	return sid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sid *SchedulerInstanceDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sid.hooks) == 0 {
+		affected, err = sid.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SchedulerInstanceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sid.mutation = mutation
+			affected, err = sid.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sid.hooks) - 1; i >= 0; i-- {
+			if sid.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sid.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sid.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sid *SchedulerInstanceDelete) ExecX(ctx context.Context) int {
+	n, err := sid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sid *SchedulerInstanceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: schedulerinstance.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: schedulerinstance.FieldID,
+			},
+		},
+	}
+	if ps := sid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// SchedulerInstanceDeleteOne is the builder for deleting a single SchedulerInstance entity.
+type SchedulerInstanceDeleteOne struct {
+	sid *SchedulerInstanceDelete
+}
+
+// Exec executes the deletion query.
+func (sido *SchedulerInstanceDeleteOne) Exec(ctx context.Context) error {
+	n, err := sido.sid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{schedulerinstance.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sido *SchedulerInstanceDeleteOne) ExecX(ctx context.Context) {
+	sido.sid.ExecX(ctx)
+}
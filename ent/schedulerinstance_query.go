@@ -0,0 +1,585 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/schedulerinstance"
+)
+
+// SchedulerInstanceQuery is the builder for querying SchedulerInstance entities.
+type SchedulerInstanceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.SchedulerInstance
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SchedulerInstanceQuery builder.
+func (siq *SchedulerInstanceQuery) Where(ps ...predicate.SchedulerInstance) *SchedulerInstanceQuery {
+	siq.predicates = append(siq.predicates, ps...)
+	return siq
+}
+
+// Limit adds a limit step to the query.
+func (siq *SchedulerInstanceQuery) Limit(limit int) *SchedulerInstanceQuery {
+	siq.limit = &limit
+	return siq
+}
+
+// Offset adds an offset step to the query.
+func (siq *SchedulerInstanceQuery) Offset(offset int) *SchedulerInstanceQuery {
+	siq.offset = &offset
+	return siq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (siq *SchedulerInstanceQuery) Unique(unique bool) *SchedulerInstanceQuery {
+	siq.unique = &unique
+	return siq
+}
+
+// Order adds an order step to the query.
+func (siq *SchedulerInstanceQuery) Order(o ...OrderFunc) *SchedulerInstanceQuery {
+	siq.order = append(siq.order, o...)
+	return siq
+}
+
+// First returns the first SchedulerInstance entity from the query.
+// Returns a *NotFoundError when no SchedulerInstance was found.
+func (siq *SchedulerInstanceQuery) First(ctx context.Context) (*SchedulerInstance, error) {
+	nodes, err := siq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{schedulerinstance.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (siq *SchedulerInstanceQuery) FirstX(ctx context.Context) *SchedulerInstance {
+	node, err := siq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SchedulerInstance ID from the query.
+// Returns a *NotFoundError when no SchedulerInstance ID was found.
+func (siq *SchedulerInstanceQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = siq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{schedulerinstance.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (siq *SchedulerInstanceQuery) FirstIDX(ctx context.Context) string {
+	id, err := siq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SchedulerInstance entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SchedulerInstance entity is found.
+// Returns a *NotFoundError when no SchedulerInstance entities are found.
+func (siq *SchedulerInstanceQuery) Only(ctx context.Context) (*SchedulerInstance, error) {
+	nodes, err := siq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{schedulerinstance.Label}
+	default:
+		return nil, &NotSingularError{schedulerinstance.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (siq *SchedulerInstanceQuery) OnlyX(ctx context.Context) *SchedulerInstance {
+	node, err := siq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SchedulerInstance ID in the query.
+// Returns a *NotSingularError when more than one SchedulerInstance ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (siq *SchedulerInstanceQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = siq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{schedulerinstance.Label}
+	default:
+		err = &NotSingularError{schedulerinstance.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (siq *SchedulerInstanceQuery) OnlyIDX(ctx context.Context) string {
+	id, err := siq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SchedulerInstances.
+func (siq *SchedulerInstanceQuery) All(ctx context.Context) ([]*SchedulerInstance, error) {
+	if err := siq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return siq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (siq *SchedulerInstanceQuery) AllX(ctx context.Context) []*SchedulerInstance {
+	nodes, err := siq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SchedulerInstance IDs.
+func (siq *SchedulerInstanceQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := siq.Select(schedulerinstance.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (siq *SchedulerInstanceQuery) IDsX(ctx context.Context) []string {
+	ids, err := siq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (siq *SchedulerInstanceQuery) Count(ctx context.Context) (int, error) {
+	if err := siq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return siq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (siq *SchedulerInstanceQuery) CountX(ctx context.Context) int {
+	count, err := siq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (siq *SchedulerInstanceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := siq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return siq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (siq *SchedulerInstanceQuery) ExistX(ctx context.Context) bool {
+	exist, err := siq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SchedulerInstanceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (siq *SchedulerInstanceQuery) Clone() *SchedulerInstanceQuery {
+	if siq == nil {
+		return nil
+	}
+	return &SchedulerInstanceQuery{
+		config:     siq.config,
+		limit:      siq.limit,
+		offset:     siq.offset,
+		order:      append([]OrderFunc{}, siq.order...),
+		predicates: append([]predicate.SchedulerInstance{}, siq.predicates...),
+		// clone intermediate query.
+		sql:    siq.sql.Clone(),
+		path:   siq.path,
+		unique: siq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		HeartbeatAt time.Time `json:"heartbeatAt,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SchedulerInstance.Query().
+//		GroupBy(schedulerinstance.FieldHeartbeatAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (siq *SchedulerInstanceQuery) GroupBy(field string, fields ...string) *SchedulerInstanceGroupBy {
+	grbuild := &SchedulerInstanceGroupBy{config: siq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := siq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return siq.sqlQuery(ctx), nil
+	}
+	grbuild.label = schedulerinstance.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		HeartbeatAt time.Time `json:"heartbeatAt,omitempty"`
+//	}
+//
+//	client.SchedulerInstance.Query().
+//		Select(schedulerinstance.FieldHeartbeatAt).
+//		Scan(ctx, &v)
+func (siq *SchedulerInstanceQuery) Select(fields ...string) *SchedulerInstanceSelect {
+	siq.fields = append(siq.fields, fields...)
+	selbuild := &SchedulerInstanceSelect{SchedulerInstanceQuery: siq}
+	selbuild.label = schedulerinstance.Label
+	selbuild.flds, selbuild.scan = &siq.fields, selbuild.Scan
+	return selbuild
+}
+
+// Aggregate returns a SchedulerInstanceSelect configured with the given aggregations.
+func (siq *SchedulerInstanceQuery) Aggregate(fns ...AggregateFunc) *SchedulerInstanceSelect {
+	return siq.Select().Aggregate(fns...)
+}
+
+func (siq *SchedulerInstanceQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range siq.fields {
+		if !schedulerinstance.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if siq.path != nil {
+		prev, err := siq.path(ctx)
+		if err != nil {
+			return err
+		}
+		siq.sql = prev
+	}
+	return nil
+}
+
+func (siq *SchedulerInstanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SchedulerInstance, error) {
+	var (
+		nodes = []*SchedulerInstance{}
+		_spec = siq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SchedulerInstance).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SchedulerInstance{config: siq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, siq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (siq *SchedulerInstanceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := siq.querySpec()
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	_spec.Node.Columns = siq.fields
+	if len(siq.fields) > 0 {
+		_spec.Unique = siq.unique != nil && *siq.unique
+	}
+	return sqlgraph.CountNodes(ctx, siq.driver, _spec)
+}
+
+func (siq *SchedulerInstanceQuery) sqlExist(ctx context.Context) (bool, error) {
+	switch _, err := siq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+func (siq *SchedulerInstanceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   schedulerinstance.Table,
+			Columns: schedulerinstance.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: schedulerinstance.FieldID,
+			},
+		},
+		From:   siq.sql,
+		Unique: true,
+	}
+	if unique := siq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := siq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedulerinstance.FieldID)
+		for i := range fields {
+			if fields[i] != schedulerinstance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := siq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := siq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := siq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := siq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (siq *SchedulerInstanceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(siq.driver.Dialect())
+	t1 := builder.Table(schedulerinstance.Table)
+	columns := siq.fields
+	if len(columns) == 0 {
+		columns = schedulerinstance.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if siq.sql != nil {
+		selector = siq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if siq.unique != nil && *siq.unique {
+		selector.Distinct()
+	}
+	for _, m := range siq.modifiers {
+		m(selector)
+	}
+	for _, p := range siq.predicates {
+		p(selector)
+	}
+	for _, p := range siq.order {
+		p(selector)
+	}
+	if offset := siq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := siq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (siq *SchedulerInstanceQuery) ForUpdate(opts ...sql.LockOption) *SchedulerInstanceQuery {
+	if siq.driver.Dialect() == dialect.Postgres {
+		siq.Unique(false)
+	}
+	siq.modifiers = append(siq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return siq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (siq *SchedulerInstanceQuery) ForShare(opts ...sql.LockOption) *SchedulerInstanceQuery {
+	if siq.driver.Dialect() == dialect.Postgres {
+		siq.Unique(false)
+	}
+	siq.modifiers = append(siq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return siq
+}
+
+// SchedulerInstanceGroupBy is the group-by builder for SchedulerInstance entities.
+type SchedulerInstanceGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sigb *SchedulerInstanceGroupBy) Aggregate(fns ...AggregateFunc) *SchedulerInstanceGroupBy {
+	sigb.fns = append(sigb.fns, fns...)
+	return sigb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (sigb *SchedulerInstanceGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := sigb.path(ctx)
+	if err != nil {
+		return err
+	}
+	sigb.sql = query
+	return sigb.sqlScan(ctx, v)
+}
+
+func (sigb *SchedulerInstanceGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range sigb.fields {
+		if !schedulerinstance.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := sigb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sigb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sigb *SchedulerInstanceGroupBy) sqlQuery() *sql.Selector {
+	selector := sigb.sql.Select()
+	aggregation := make([]string, 0, len(sigb.fns))
+	for _, fn := range sigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(sigb.fields)+len(sigb.fns))
+		for _, f := range sigb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(sigb.fields...)...)
+}
+
+// SchedulerInstanceSelect is the builder for selecting fields of SchedulerInstance entities.
+type SchedulerInstanceSelect struct {
+	*SchedulerInstanceQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sis *SchedulerInstanceSelect) Aggregate(fns ...AggregateFunc) *SchedulerInstanceSelect {
+	sis.fns = append(sis.fns, fns...)
+	return sis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sis *SchedulerInstanceSelect) Scan(ctx context.Context, v any) error {
+	if err := sis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sis.sql = sis.SchedulerInstanceQuery.sqlQuery(ctx)
+	return sis.sqlScan(ctx, v)
+}
+
+func (sis *SchedulerInstanceSelect) sqlScan(ctx context.Context, v any) error {
+	aggregation := make([]string, 0, len(sis.fns))
+	for _, fn := range sis.fns {
+		aggregation = append(aggregation, fn(sis.sql))
+	}
+	switch n := len(*sis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		sis.sql.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		sis.sql.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := sis.sql.Query()
+	if err := sis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/schedulerinstance"
+)
+
+// SchedulerInstanceUpdate is the builder for updating SchedulerInstance entities.
+type SchedulerInstanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SchedulerInstanceMutation
+}
+
+// Where appends a list predicates to the SchedulerInstanceUpdate builder.
+func (siu *SchedulerInstanceUpdate) Where(ps ...predicate.SchedulerInstance) *SchedulerInstanceUpdate {
+	siu.mutation.Where(ps...)
+	return siu
+}
+
+// SetHeartbeatAt sets the "heartbeatAt" field.
+func (siu *SchedulerInstanceUpdate) SetHeartbeatAt(t time.Time) *SchedulerInstanceUpdate {
+	siu.mutation.SetHeartbeatAt(t)
+	return siu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (siu *SchedulerInstanceUpdate) SetCreatedAt(t time.Time) *SchedulerInstanceUpdate {
+	siu.mutation.SetCreatedAt(t)
+	return siu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (siu *SchedulerInstanceUpdate) SetNillableCreatedAt(t *time.Time) *SchedulerInstanceUpdate {
+	if t != nil {
+		siu.SetCreatedAt(*t)
+	}
+	return siu
+}
+
+// Mutation returns the SchedulerInstanceMutation object of the builder.
+func (siu *SchedulerInstanceUpdate) Mutation() *SchedulerInstanceMutation {
+	return siu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (siu *SchedulerInstanceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(siu.hooks) == 0 {
+		affected, err = siu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SchedulerInstanceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			siu.mutation = mutation
+			affected, err = siu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(siu.hooks) - 1; i >= 0; i-- {
+			if siu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = siu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, siu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siu *SchedulerInstanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := siu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (siu *SchedulerInstanceUpdate) Exec(ctx context.Context) error {
+	_, err := siu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siu *SchedulerInstanceUpdate) ExecX(ctx context.Context) {
+	if err := siu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (siu *SchedulerInstanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   schedulerinstance.Table,
+			Columns: schedulerinstance.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: schedulerinstance.FieldID,
+			},
+		},
+	}
+	if ps := siu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siu.mutation.HeartbeatAt(); ok {
+		_spec.SetField(schedulerinstance.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if value, ok := siu.mutation.CreatedAt(); ok {
+		_spec.SetField(schedulerinstance.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, siu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedulerinstance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SchedulerInstanceUpdateOne is the builder for updating a single SchedulerInstance entity.
+type SchedulerInstanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SchedulerInstanceMutation
+}
+
+// SetHeartbeatAt sets the "heartbeatAt" field.
+func (siuo *SchedulerInstanceUpdateOne) SetHeartbeatAt(t time.Time) *SchedulerInstanceUpdateOne {
+	siuo.mutation.SetHeartbeatAt(t)
+	return siuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (siuo *SchedulerInstanceUpdateOne) SetCreatedAt(t time.Time) *SchedulerInstanceUpdateOne {
+	siuo.mutation.SetCreatedAt(t)
+	return siuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (siuo *SchedulerInstanceUpdateOne) SetNillableCreatedAt(t *time.Time) *SchedulerInstanceUpdateOne {
+	if t != nil {
+		siuo.SetCreatedAt(*t)
+	}
+	return siuo
+}
+
+// Mutation returns the SchedulerInstanceMutation object of the builder.
+func (siuo *SchedulerInstanceUpdateOne) Mutation() *SchedulerInstanceMutation {
+	return siuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (siuo *SchedulerInstanceUpdateOne) Select(field string, fields ...string) *SchedulerInstanceUpdateOne {
+	siuo.fields = append([]string{field}, fields...)
+	return siuo
+}
+
+// Save executes the query and returns the updated SchedulerInstance entity.
+func (siuo *SchedulerInstanceUpdateOne) Save(ctx context.Context) (*SchedulerInstance, error) {
+	var (
+		err  error
+		node *SchedulerInstance
+	)
+	if len(siuo.hooks) == 0 {
+		node, err = siuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SchedulerInstanceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			siuo.mutation = mutation
+			node, err = siuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(siuo.hooks) - 1; i >= 0; i-- {
+			if siuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = siuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, siuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SchedulerInstance)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SchedulerInstanceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siuo *SchedulerInstanceUpdateOne) SaveX(ctx context.Context) *SchedulerInstance {
+	node, err := siuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (siuo *SchedulerInstanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := siuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siuo *SchedulerInstanceUpdateOne) ExecX(ctx context.Context) {
+	if err := siuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (siuo *SchedulerInstanceUpdateOne) sqlSave(ctx context.Context) (_node *SchedulerInstance, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   schedulerinstance.Table,
+			Columns: schedulerinstance.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: schedulerinstance.FieldID,
+			},
+		},
+	}
+	id, ok := siuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SchedulerInstance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := siuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedulerinstance.FieldID)
+		for _, f := range fields {
+			if !schedulerinstance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schedulerinstance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := siuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siuo.mutation.HeartbeatAt(); ok {
+		_spec.SetField(schedulerinstance.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if value, ok := siuo.mutation.CreatedAt(); ok {
+		_spec.SetField(schedulerinstance.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &SchedulerInstance{config: siuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, siuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedulerinstance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
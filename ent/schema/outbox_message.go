@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// OutboxMessage is a queue message that is written in the transaction that claims its task,
+// and published by the outbox relay after the transaction is committed
+type OutboxMessage struct {
+	ent.Schema
+}
+
+func (OutboxMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("taskId"),
+		// payload is the json of the task as it was claimed
+		field.Bytes("payload"),
+		// sentAt is set when the message was published, unsent messages are retried by the relay
+		field.Time("sentAt").Optional().Nillable(),
+		// relayUntil is set while a relay publishes the message, other relays skip it until then
+		field.Time("relayUntil").Optional().Nillable(),
+		field.Int("attempts").Default(0),
+		field.String("lastError").Optional().Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+func (OutboxMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sentAt"),
+		index.Fields("taskId", "sentAt"),
+	}
+}
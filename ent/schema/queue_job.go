@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// QueueJob is a message of the database queue backend, see the db_queue package
+type QueueJob struct {
+	ent.Schema
+}
+
+func (QueueJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("queue"),
+		field.Bytes("payload"),
+		// visibleAt is when the job can be claimed, claiming a job hides it for the visibility timeout
+		field.Time("visibleAt").
+			SchemaType(map[string]string{dialect.MySQL: "timestamp(3)"}),
+		// deliveries is the number of times the job was claimed since it was published or replayed
+		field.Int("deliveries").Default(0),
+		// deadAt is set when the job was dead lettered, dead jobs are never claimed
+		field.Time("deadAt").Optional().Nillable(),
+		field.Int("deadCount").Default(0),
+		field.String("deadReason").Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+func (QueueJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("queue", "deadAt", "visibleAt"),
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// SchedulerInstance is a running scheduler, instances that didn't send a heartbeat lately are considered gone
+type SchedulerInstance struct {
+	ent.Schema
+}
+
+func (SchedulerInstance) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.Time("heartbeatAt"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+func (SchedulerInstance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("heartbeatAt"),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -14,22 +15,59 @@ type Task struct {
 
 func (Task) Fields() []ent.Field {
 	return []ent.Field{
-		field.Time("dueDate"),
+		// dueDate is stored with milliseconds
+		field.Time("dueDate").
+			SchemaType(map[string]string{dialect.MySQL: "timestamp(3)"}),
 		field.String("webhookUrl"),
-		field.Enum("status").Values("pending", "running", "done").Default("pending"),
+		field.String("webhookHost").Default(""),
+		field.String("method").Default("POST"),
+		field.JSON("headers", map[string]string{}).Optional(),
+		field.Text("body").Optional(),
+		field.Bool("omitIdSuffix").Default(false),
+		field.String("cron").Optional(),
+		field.Enum("status").Values("pending", "running", "delivering", "done", "failed", "cancelled").Default("pending"),
+		field.Int("attempts").Default(0),
+		// deliveryId identifies a single fire of the task, it's kept across the retries of the fire so receivers can dedupe
+		field.String("deliveryId").Optional(),
+		// leaseExpiresAt is set while the task is running or delivering, a task with expired lease is reclaimed by the reaper
+		field.Time("leaseExpiresAt").Optional().Nillable(),
+		field.Int("reclaims").Default(0),
+		// shard partitions the tasks between the scheduler instances
+		field.Int("shard").Default(0),
+		field.Int("maxAttempts").Optional().Nillable(),
+		field.Int64("retryBaseDelayMs").Optional().Nillable(),
+		field.Int64("retryMaxDelayMs").Optional().Nillable(),
+		field.Float("retryJitter").Optional().Nillable(),
+		// caller and idempotencyKey identify the request that created the task, requestHash detects reuse of a key with another request
+		field.String("caller").Default(""),
+		field.String("idempotencyKey").Optional().Nillable(),
+		field.String("requestHash").Optional(),
 		field.Time("created_at").
 			Default(time.Now),
+		// updated_at is used as the task version for optimistic concurrency, so it's stored with microseconds
 		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now),
+			Default(nowMicro).
+			UpdateDefault(nowMicro).
+			SchemaType(map[string]string{dialect.MySQL: "timestamp(6)"}),
 	}
 }
 
+// nowMicro returns the current time truncated to the precision MySQL keeps, so the value
+// returned on save matches the one read back later
+func nowMicro() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
 func (Task) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("dueDate"),
 		index.Fields("status"),
 		index.Fields("dueDate", "status"),
+		index.Fields("status", "leaseExpiresAt"),
+		index.Fields("shard", "status", "dueDate"),
+		index.Fields("webhookHost"),
+		index.Fields("created_at"),
+		index.Fields("caller", "idempotencyKey").Unique(),
 	}
 }
 
@@ -13,7 +13,14 @@ type TaskHistory struct {
 
 func (TaskHistory) Fields() []ent.Field {
 	return []ent.Field{
+		// event is run for a webhook call, or reclaim when the lease of the task expired before it was delivered
+		field.Enum("event").Values("run", "reclaim").Default("run"),
 		field.String("error").Optional().Nillable(),
+		field.Time("runAt").
+			Default(time.Now),
+		field.Int("attempt").Default(1),
+		field.Int("httpStatus").Optional().Nillable(),
+		field.Int64("latencyMs").Default(0),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
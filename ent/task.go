@@ -3,12 +3,13 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
-	"github.com/Av1shay/timers-scheduler-demo/ent/task"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
 )
 
 // Task is the model entity for the Task schema.
@@ -20,8 +21,44 @@ type Task struct {
 	DueDate time.Time `json:"dueDate,omitempty"`
 	// WebhookUrl holds the value of the "webhookUrl" field.
 	WebhookUrl string `json:"webhookUrl,omitempty"`
+	// WebhookHost holds the value of the "webhookHost" field.
+	WebhookHost string `json:"webhookHost,omitempty"`
+	// Method holds the value of the "method" field.
+	Method string `json:"method,omitempty"`
+	// Headers holds the value of the "headers" field.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// OmitIdSuffix holds the value of the "omitIdSuffix" field.
+	OmitIdSuffix bool `json:"omitIdSuffix,omitempty"`
+	// Cron holds the value of the "cron" field.
+	Cron string `json:"cron,omitempty"`
 	// Status holds the value of the "status" field.
 	Status task.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// DeliveryId holds the value of the "deliveryId" field.
+	DeliveryId string `json:"deliveryId,omitempty"`
+	// LeaseExpiresAt holds the value of the "leaseExpiresAt" field.
+	LeaseExpiresAt *time.Time `json:"leaseExpiresAt,omitempty"`
+	// Reclaims holds the value of the "reclaims" field.
+	Reclaims int `json:"reclaims,omitempty"`
+	// Shard holds the value of the "shard" field.
+	Shard int `json:"shard,omitempty"`
+	// MaxAttempts holds the value of the "maxAttempts" field.
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+	// RetryBaseDelayMs holds the value of the "retryBaseDelayMs" field.
+	RetryBaseDelayMs *int64 `json:"retryBaseDelayMs,omitempty"`
+	// RetryMaxDelayMs holds the value of the "retryMaxDelayMs" field.
+	RetryMaxDelayMs *int64 `json:"retryMaxDelayMs,omitempty"`
+	// RetryJitter holds the value of the "retryJitter" field.
+	RetryJitter *float64 `json:"retryJitter,omitempty"`
+	// Caller holds the value of the "caller" field.
+	Caller string `json:"caller,omitempty"`
+	// IdempotencyKey holds the value of the "idempotencyKey" field.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+	// RequestHash holds the value of the "requestHash" field.
+	RequestHash string `json:"requestHash,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -54,11 +91,17 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldID:
+		case task.FieldHeaders:
+			values[i] = new([]byte)
+		case task.FieldOmitIdSuffix:
+			values[i] = new(sql.NullBool)
+		case task.FieldRetryJitter:
+			values[i] = new(sql.NullFloat64)
+		case task.FieldID, task.FieldAttempts, task.FieldReclaims, task.FieldShard, task.FieldMaxAttempts, task.FieldRetryBaseDelayMs, task.FieldRetryMaxDelayMs:
 			values[i] = new(sql.NullInt64)
-		case task.FieldWebhookUrl, task.FieldStatus:
+		case task.FieldWebhookUrl, task.FieldWebhookHost, task.FieldMethod, task.FieldBody, task.FieldCron, task.FieldStatus, task.FieldDeliveryId, task.FieldCaller, task.FieldIdempotencyKey, task.FieldRequestHash:
 			values[i] = new(sql.NullString)
-		case task.FieldDueDate, task.FieldCreatedAt, task.FieldUpdatedAt:
+		case task.FieldDueDate, task.FieldLeaseExpiresAt, task.FieldCreatedAt, task.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Task", columns[i])
@@ -93,12 +136,128 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.WebhookUrl = value.String
 			}
+		case task.FieldWebhookHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webhookHost", values[i])
+			} else if value.Valid {
+				t.WebhookHost = value.String
+			}
+		case task.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				t.Method = value.String
+			}
+		case task.FieldHeaders:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field headers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Headers); err != nil {
+					return fmt.Errorf("unmarshal field headers: %w", err)
+				}
+			}
+		case task.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				t.Body = value.String
+			}
+		case task.FieldOmitIdSuffix:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field omitIdSuffix", values[i])
+			} else if value.Valid {
+				t.OmitIdSuffix = value.Bool
+			}
+		case task.FieldCron:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron", values[i])
+			} else if value.Valid {
+				t.Cron = value.String
+			}
 		case task.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				t.Status = task.Status(value.String)
 			}
+		case task.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				t.Attempts = int(value.Int64)
+			}
+		case task.FieldDeliveryId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deliveryId", values[i])
+			} else if value.Valid {
+				t.DeliveryId = value.String
+			}
+		case task.FieldLeaseExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field leaseExpiresAt", values[i])
+			} else if value.Valid {
+				t.LeaseExpiresAt = new(time.Time)
+				*t.LeaseExpiresAt = value.Time
+			}
+		case task.FieldReclaims:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reclaims", values[i])
+			} else if value.Valid {
+				t.Reclaims = int(value.Int64)
+			}
+		case task.FieldShard:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shard", values[i])
+			} else if value.Valid {
+				t.Shard = int(value.Int64)
+			}
+		case task.FieldMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field maxAttempts", values[i])
+			} else if value.Valid {
+				t.MaxAttempts = new(int)
+				*t.MaxAttempts = int(value.Int64)
+			}
+		case task.FieldRetryBaseDelayMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retryBaseDelayMs", values[i])
+			} else if value.Valid {
+				t.RetryBaseDelayMs = new(int64)
+				*t.RetryBaseDelayMs = value.Int64
+			}
+		case task.FieldRetryMaxDelayMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retryMaxDelayMs", values[i])
+			} else if value.Valid {
+				t.RetryMaxDelayMs = new(int64)
+				*t.RetryMaxDelayMs = value.Int64
+			}
+		case task.FieldRetryJitter:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field retryJitter", values[i])
+			} else if value.Valid {
+				t.RetryJitter = new(float64)
+				*t.RetryJitter = value.Float64
+			}
+		case task.FieldCaller:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caller", values[i])
+			} else if value.Valid {
+				t.Caller = value.String
+			}
+		case task.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotencyKey", values[i])
+			} else if value.Valid {
+				t.IdempotencyKey = new(string)
+				*t.IdempotencyKey = value.String
+			}
+		case task.FieldRequestHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requestHash", values[i])
+			} else if value.Valid {
+				t.RequestHash = value.String
+			}
 		case task.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -150,9 +309,75 @@ func (t *Task) String() string {
 	builder.WriteString("webhookUrl=")
 	builder.WriteString(t.WebhookUrl)
 	builder.WriteString(", ")
+	builder.WriteString("webhookHost=")
+	builder.WriteString(t.WebhookHost)
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(t.Method)
+	builder.WriteString(", ")
+	builder.WriteString("headers=")
+	builder.WriteString(fmt.Sprintf("%v", t.Headers))
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(t.Body)
+	builder.WriteString(", ")
+	builder.WriteString("omitIdSuffix=")
+	builder.WriteString(fmt.Sprintf("%v", t.OmitIdSuffix))
+	builder.WriteString(", ")
+	builder.WriteString("cron=")
+	builder.WriteString(t.Cron)
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", t.Status))
 	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", t.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("deliveryId=")
+	builder.WriteString(t.DeliveryId)
+	builder.WriteString(", ")
+	if v := t.LeaseExpiresAt; v != nil {
+		builder.WriteString("leaseExpiresAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reclaims=")
+	builder.WriteString(fmt.Sprintf("%v", t.Reclaims))
+	builder.WriteString(", ")
+	builder.WriteString("shard=")
+	builder.WriteString(fmt.Sprintf("%v", t.Shard))
+	builder.WriteString(", ")
+	if v := t.MaxAttempts; v != nil {
+		builder.WriteString("maxAttempts=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.RetryBaseDelayMs; v != nil {
+		builder.WriteString("retryBaseDelayMs=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.RetryMaxDelayMs; v != nil {
+		builder.WriteString("retryMaxDelayMs=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.RetryJitter; v != nil {
+		builder.WriteString("retryJitter=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("caller=")
+	builder.WriteString(t.Caller)
+	builder.WriteString(", ")
+	if v := t.IdempotencyKey; v != nil {
+		builder.WriteString("idempotencyKey=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("requestHash=")
+	builder.WriteString(t.RequestHash)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -16,8 +16,44 @@ const (
 	FieldDueDate = "due_date"
 	// FieldWebhookUrl holds the string denoting the webhookurl field in the database.
 	FieldWebhookUrl = "webhook_url"
+	// FieldWebhookHost holds the string denoting the webhookhost field in the database.
+	FieldWebhookHost = "webhook_host"
+	// FieldMethod holds the string denoting the method field in the database.
+	FieldMethod = "method"
+	// FieldHeaders holds the string denoting the headers field in the database.
+	FieldHeaders = "headers"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldOmitIdSuffix holds the string denoting the omitidsuffix field in the database.
+	FieldOmitIdSuffix = "omit_id_suffix"
+	// FieldCron holds the string denoting the cron field in the database.
+	FieldCron = "cron"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldDeliveryId holds the string denoting the deliveryid field in the database.
+	FieldDeliveryId = "delivery_id"
+	// FieldLeaseExpiresAt holds the string denoting the leaseexpiresat field in the database.
+	FieldLeaseExpiresAt = "lease_expires_at"
+	// FieldReclaims holds the string denoting the reclaims field in the database.
+	FieldReclaims = "reclaims"
+	// FieldShard holds the string denoting the shard field in the database.
+	FieldShard = "shard"
+	// FieldMaxAttempts holds the string denoting the maxattempts field in the database.
+	FieldMaxAttempts = "max_attempts"
+	// FieldRetryBaseDelayMs holds the string denoting the retrybasedelayms field in the database.
+	FieldRetryBaseDelayMs = "retry_base_delay_ms"
+	// FieldRetryMaxDelayMs holds the string denoting the retrymaxdelayms field in the database.
+	FieldRetryMaxDelayMs = "retry_max_delay_ms"
+	// FieldRetryJitter holds the string denoting the retryjitter field in the database.
+	FieldRetryJitter = "retry_jitter"
+	// FieldCaller holds the string denoting the caller field in the database.
+	FieldCaller = "caller"
+	// FieldIdempotencyKey holds the string denoting the idempotencykey field in the database.
+	FieldIdempotencyKey = "idempotency_key"
+	// FieldRequestHash holds the string denoting the requesthash field in the database.
+	FieldRequestHash = "request_hash"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -40,7 +76,25 @@ var Columns = []string{
 	FieldID,
 	FieldDueDate,
 	FieldWebhookUrl,
+	FieldWebhookHost,
+	FieldMethod,
+	FieldHeaders,
+	FieldBody,
+	FieldOmitIdSuffix,
+	FieldCron,
 	FieldStatus,
+	FieldAttempts,
+	FieldDeliveryId,
+	FieldLeaseExpiresAt,
+	FieldReclaims,
+	FieldShard,
+	FieldMaxAttempts,
+	FieldRetryBaseDelayMs,
+	FieldRetryMaxDelayMs,
+	FieldRetryJitter,
+	FieldCaller,
+	FieldIdempotencyKey,
+	FieldRequestHash,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -56,6 +110,20 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultWebhookHost holds the default value on creation for the "webhookHost" field.
+	DefaultWebhookHost string
+	// DefaultMethod holds the default value on creation for the "method" field.
+	DefaultMethod string
+	// DefaultOmitIdSuffix holds the default value on creation for the "omitIdSuffix" field.
+	DefaultOmitIdSuffix bool
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultReclaims holds the default value on creation for the "reclaims" field.
+	DefaultReclaims int
+	// DefaultShard holds the default value on creation for the "shard" field.
+	DefaultShard int
+	// DefaultCaller holds the default value on creation for the "caller" field.
+	DefaultCaller string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -72,9 +140,12 @@ const DefaultStatus = StatusPending
 
 // Status values.
 const (
-	StatusPending Status = "pending"
-	StatusRunning Status = "running"
-	StatusDone    Status = "done"
+	StatusPending    Status = "pending"
+	StatusRunning    Status = "running"
+	StatusDelivering Status = "delivering"
+	StatusDone       Status = "done"
+	StatusFailed     Status = "failed"
+	StatusCancelled  Status = "cancelled"
 )
 
 func (s Status) String() string {
@@ -84,7 +155,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPending, StatusRunning, StatusDone:
+	case StatusPending, StatusRunning, StatusDelivering, StatusDone, StatusFailed, StatusCancelled:
 		return nil
 	default:
 		return fmt.Errorf("task: invalid enum value for status field: %q", s)
@@ -3,11 +3,11 @@
 package task
 
 import (
-	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
 )
 
 // ID filters vertices based on their ID field.
@@ -95,6 +95,125 @@ func WebhookUrl(v string) predicate.Task {
 	})
 }
 
+// WebhookHost applies equality check predicate on the "webhookHost" field. It's identical to WebhookHostEQ.
+func WebhookHost(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWebhookHost), v))
+	})
+}
+
+// Method applies equality check predicate on the "method" field. It's identical to MethodEQ.
+func Method(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMethod), v))
+	})
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBody), v))
+	})
+}
+
+// OmitIdSuffix applies equality check predicate on the "omitIdSuffix" field. It's identical to OmitIdSuffixEQ.
+func OmitIdSuffix(v bool) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOmitIdSuffix), v))
+	})
+}
+
+// Cron applies equality check predicate on the "cron" field. It's identical to CronEQ.
+func Cron(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCron), v))
+	})
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempts), v))
+	})
+}
+
+// DeliveryId applies equality check predicate on the "deliveryId" field. It's identical to DeliveryIdEQ.
+func DeliveryId(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeliveryId), v))
+	})
+}
+
+// LeaseExpiresAt applies equality check predicate on the "leaseExpiresAt" field. It's identical to LeaseExpiresAtEQ.
+func LeaseExpiresAt(v time.Time) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLeaseExpiresAt), v))
+	})
+}
+
+// Reclaims applies equality check predicate on the "reclaims" field. It's identical to ReclaimsEQ.
+func Reclaims(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReclaims), v))
+	})
+}
+
+// Shard applies equality check predicate on the "shard" field. It's identical to ShardEQ.
+func Shard(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldShard), v))
+	})
+}
+
+// MaxAttempts applies equality check predicate on the "maxAttempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxAttempts), v))
+	})
+}
+
+// RetryBaseDelayMs applies equality check predicate on the "retryBaseDelayMs" field. It's identical to RetryBaseDelayMsEQ.
+func RetryBaseDelayMs(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetryBaseDelayMs), v))
+	})
+}
+
+// RetryMaxDelayMs applies equality check predicate on the "retryMaxDelayMs" field. It's identical to RetryMaxDelayMsEQ.
+func RetryMaxDelayMs(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetryMaxDelayMs), v))
+	})
+}
+
+// RetryJitter applies equality check predicate on the "retryJitter" field. It's identical to RetryJitterEQ.
+func RetryJitter(v float64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetryJitter), v))
+	})
+}
+
+// Caller applies equality check predicate on the "caller" field. It's identical to CallerEQ.
+func Caller(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCaller), v))
+	})
+}
+
+// IdempotencyKey applies equality check predicate on the "idempotencyKey" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// RequestHash applies equality check predicate on the "requestHash" field. It's identical to RequestHashEQ.
+func RequestHash(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRequestHash), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
@@ -272,39 +391,1511 @@ func WebhookUrlContainsFold(v string) predicate.Task {
 	})
 }
 
-// StatusEQ applies the EQ predicate on the "status" field.
-func StatusEQ(v Status) predicate.Task {
+// WebhookHostEQ applies the EQ predicate on the "webhookHost" field.
+func WebhookHostEQ(v string) predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldStatus), v))
+		s.Where(sql.EQ(s.C(FieldWebhookHost), v))
 	})
 }
 
-// StatusNEQ applies the NEQ predicate on the "status" field.
-func StatusNEQ(v Status) predicate.Task {
+// WebhookHostNEQ applies the NEQ predicate on the "webhookHost" field.
+func WebhookHostNEQ(v string) predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldStatus), v))
+		s.Where(sql.NEQ(s.C(FieldWebhookHost), v))
 	})
 }
 
-// StatusIn applies the In predicate on the "status" field.
-func StatusIn(vs ...Status) predicate.Task {
+// WebhookHostIn applies the In predicate on the "webhookHost" field.
+func WebhookHostIn(vs ...string) predicate.Task {
 	v := make([]any, len(vs))
 	for i := range v {
 		v[i] = vs[i]
 	}
 	return predicate.Task(func(s *sql.Selector) {
-		s.Where(sql.In(s.C(FieldStatus), v...))
+		s.Where(sql.In(s.C(FieldWebhookHost), v...))
 	})
 }
 
-// StatusNotIn applies the NotIn predicate on the "status" field.
-func StatusNotIn(vs ...Status) predicate.Task {
+// WebhookHostNotIn applies the NotIn predicate on the "webhookHost" field.
+func WebhookHostNotIn(vs ...string) predicate.Task {
 	v := make([]any, len(vs))
 	for i := range v {
 		v[i] = vs[i]
 	}
 	return predicate.Task(func(s *sql.Selector) {
-		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+		s.Where(sql.NotIn(s.C(FieldWebhookHost), v...))
+	})
+}
+
+// WebhookHostGT applies the GT predicate on the "webhookHost" field.
+func WebhookHostGT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWebhookHost), v))
+	})
+}
+
+// WebhookHostGTE applies the GTE predicate on the "webhookHost" field.
+func WebhookHostGTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWebhookHost), v))
+	})
+}
+
+// WebhookHostLT applies the LT predicate on the "webhookHost" field.
+func WebhookHostLT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWebhookHost), v))
+	})
+}
+
+// WebhookHostLTE applies the LTE predicate on the "webhookHost" field.
+func WebhookHostLTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWebhookHost), v))
+	})
+}
+
+// WebhookHostContains applies the Contains predicate on the "webhookHost" field.
+func WebhookHostContains(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldWebhookHost), v))
+	})
+}
+
+// WebhookHostHasPrefix applies the HasPrefix predicate on the "webhookHost" field.
+func WebhookHostHasPrefix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldWebhookHost), v))
+	})
+}
+
+// WebhookHostHasSuffix applies the HasSuffix predicate on the "webhookHost" field.
+func WebhookHostHasSuffix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldWebhookHost), v))
+	})
+}
+
+// WebhookHostEqualFold applies the EqualFold predicate on the "webhookHost" field.
+func WebhookHostEqualFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldWebhookHost), v))
+	})
+}
+
+// WebhookHostContainsFold applies the ContainsFold predicate on the "webhookHost" field.
+func WebhookHostContainsFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldWebhookHost), v))
+	})
+}
+
+// MethodEQ applies the EQ predicate on the "method" field.
+func MethodEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMethod), v))
+	})
+}
+
+// MethodNEQ applies the NEQ predicate on the "method" field.
+func MethodNEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMethod), v))
+	})
+}
+
+// MethodIn applies the In predicate on the "method" field.
+func MethodIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldMethod), v...))
+	})
+}
+
+// MethodNotIn applies the NotIn predicate on the "method" field.
+func MethodNotIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldMethod), v...))
+	})
+}
+
+// MethodGT applies the GT predicate on the "method" field.
+func MethodGT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMethod), v))
+	})
+}
+
+// MethodGTE applies the GTE predicate on the "method" field.
+func MethodGTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMethod), v))
+	})
+}
+
+// MethodLT applies the LT predicate on the "method" field.
+func MethodLT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMethod), v))
+	})
+}
+
+// MethodLTE applies the LTE predicate on the "method" field.
+func MethodLTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMethod), v))
+	})
+}
+
+// MethodContains applies the Contains predicate on the "method" field.
+func MethodContains(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldMethod), v))
+	})
+}
+
+// MethodHasPrefix applies the HasPrefix predicate on the "method" field.
+func MethodHasPrefix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldMethod), v))
+	})
+}
+
+// MethodHasSuffix applies the HasSuffix predicate on the "method" field.
+func MethodHasSuffix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldMethod), v))
+	})
+}
+
+// MethodEqualFold applies the EqualFold predicate on the "method" field.
+func MethodEqualFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldMethod), v))
+	})
+}
+
+// MethodContainsFold applies the ContainsFold predicate on the "method" field.
+func MethodContainsFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldMethod), v))
+	})
+}
+
+// HeadersIsNil applies the IsNil predicate on the "headers" field.
+func HeadersIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldHeaders)))
+	})
+}
+
+// HeadersNotNil applies the NotNil predicate on the "headers" field.
+func HeadersNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldHeaders)))
+	})
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBody), v))
+	})
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBody), v))
+	})
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldBody), v...))
+	})
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldBody), v...))
+	})
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBody), v))
+	})
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBody), v))
+	})
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBody), v))
+	})
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBody), v))
+	})
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBody), v))
+	})
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBody), v))
+	})
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBody), v))
+	})
+}
+
+// BodyIsNil applies the IsNil predicate on the "body" field.
+func BodyIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBody)))
+	})
+}
+
+// BodyNotNil applies the NotNil predicate on the "body" field.
+func BodyNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBody)))
+	})
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBody), v))
+	})
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBody), v))
+	})
+}
+
+// OmitIdSuffixEQ applies the EQ predicate on the "omitIdSuffix" field.
+func OmitIdSuffixEQ(v bool) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOmitIdSuffix), v))
+	})
+}
+
+// OmitIdSuffixNEQ applies the NEQ predicate on the "omitIdSuffix" field.
+func OmitIdSuffixNEQ(v bool) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOmitIdSuffix), v))
+	})
+}
+
+// CronEQ applies the EQ predicate on the "cron" field.
+func CronEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCron), v))
+	})
+}
+
+// CronNEQ applies the NEQ predicate on the "cron" field.
+func CronNEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCron), v))
+	})
+}
+
+// CronIn applies the In predicate on the "cron" field.
+func CronIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCron), v...))
+	})
+}
+
+// CronNotIn applies the NotIn predicate on the "cron" field.
+func CronNotIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCron), v...))
+	})
+}
+
+// CronGT applies the GT predicate on the "cron" field.
+func CronGT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCron), v))
+	})
+}
+
+// CronGTE applies the GTE predicate on the "cron" field.
+func CronGTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCron), v))
+	})
+}
+
+// CronLT applies the LT predicate on the "cron" field.
+func CronLT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCron), v))
+	})
+}
+
+// CronLTE applies the LTE predicate on the "cron" field.
+func CronLTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCron), v))
+	})
+}
+
+// CronContains applies the Contains predicate on the "cron" field.
+func CronContains(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCron), v))
+	})
+}
+
+// CronHasPrefix applies the HasPrefix predicate on the "cron" field.
+func CronHasPrefix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCron), v))
+	})
+}
+
+// CronHasSuffix applies the HasSuffix predicate on the "cron" field.
+func CronHasSuffix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCron), v))
+	})
+}
+
+// CronIsNil applies the IsNil predicate on the "cron" field.
+func CronIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCron)))
+	})
+}
+
+// CronNotNil applies the NotNil predicate on the "cron" field.
+func CronNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCron)))
+	})
+}
+
+// CronEqualFold applies the EqualFold predicate on the "cron" field.
+func CronEqualFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCron), v))
+	})
+}
+
+// CronContainsFold applies the ContainsFold predicate on the "cron" field.
+func CronContainsFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCron), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAttempts), v...))
+	})
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAttempts), v...))
+	})
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAttempts), v))
+	})
+}
+
+// DeliveryIdEQ applies the EQ predicate on the "deliveryId" field.
+func DeliveryIdEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeliveryId), v))
+	})
+}
+
+// DeliveryIdNEQ applies the NEQ predicate on the "deliveryId" field.
+func DeliveryIdNEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeliveryId), v))
+	})
+}
+
+// DeliveryIdIn applies the In predicate on the "deliveryId" field.
+func DeliveryIdIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDeliveryId), v...))
+	})
+}
+
+// DeliveryIdNotIn applies the NotIn predicate on the "deliveryId" field.
+func DeliveryIdNotIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDeliveryId), v...))
+	})
+}
+
+// DeliveryIdGT applies the GT predicate on the "deliveryId" field.
+func DeliveryIdGT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeliveryId), v))
+	})
+}
+
+// DeliveryIdGTE applies the GTE predicate on the "deliveryId" field.
+func DeliveryIdGTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeliveryId), v))
+	})
+}
+
+// DeliveryIdLT applies the LT predicate on the "deliveryId" field.
+func DeliveryIdLT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeliveryId), v))
+	})
+}
+
+// DeliveryIdLTE applies the LTE predicate on the "deliveryId" field.
+func DeliveryIdLTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeliveryId), v))
+	})
+}
+
+// DeliveryIdContains applies the Contains predicate on the "deliveryId" field.
+func DeliveryIdContains(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDeliveryId), v))
+	})
+}
+
+// DeliveryIdHasPrefix applies the HasPrefix predicate on the "deliveryId" field.
+func DeliveryIdHasPrefix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDeliveryId), v))
+	})
+}
+
+// DeliveryIdHasSuffix applies the HasSuffix predicate on the "deliveryId" field.
+func DeliveryIdHasSuffix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDeliveryId), v))
+	})
+}
+
+// DeliveryIdIsNil applies the IsNil predicate on the "deliveryId" field.
+func DeliveryIdIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeliveryId)))
+	})
+}
+
+// DeliveryIdNotNil applies the NotNil predicate on the "deliveryId" field.
+func DeliveryIdNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeliveryId)))
+	})
+}
+
+// DeliveryIdEqualFold applies the EqualFold predicate on the "deliveryId" field.
+func DeliveryIdEqualFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDeliveryId), v))
+	})
+}
+
+// DeliveryIdContainsFold applies the ContainsFold predicate on the "deliveryId" field.
+func DeliveryIdContainsFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDeliveryId), v))
+	})
+}
+
+// LeaseExpiresAtEQ applies the EQ predicate on the "leaseExpiresAt" field.
+func LeaseExpiresAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLeaseExpiresAt), v))
+	})
+}
+
+// LeaseExpiresAtNEQ applies the NEQ predicate on the "leaseExpiresAt" field.
+func LeaseExpiresAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLeaseExpiresAt), v))
+	})
+}
+
+// LeaseExpiresAtIn applies the In predicate on the "leaseExpiresAt" field.
+func LeaseExpiresAtIn(vs ...time.Time) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLeaseExpiresAt), v...))
+	})
+}
+
+// LeaseExpiresAtNotIn applies the NotIn predicate on the "leaseExpiresAt" field.
+func LeaseExpiresAtNotIn(vs ...time.Time) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLeaseExpiresAt), v...))
+	})
+}
+
+// LeaseExpiresAtGT applies the GT predicate on the "leaseExpiresAt" field.
+func LeaseExpiresAtGT(v time.Time) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLeaseExpiresAt), v))
+	})
+}
+
+// LeaseExpiresAtGTE applies the GTE predicate on the "leaseExpiresAt" field.
+func LeaseExpiresAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLeaseExpiresAt), v))
+	})
+}
+
+// LeaseExpiresAtLT applies the LT predicate on the "leaseExpiresAt" field.
+func LeaseExpiresAtLT(v time.Time) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLeaseExpiresAt), v))
+	})
+}
+
+// LeaseExpiresAtLTE applies the LTE predicate on the "leaseExpiresAt" field.
+func LeaseExpiresAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLeaseExpiresAt), v))
+	})
+}
+
+// LeaseExpiresAtIsNil applies the IsNil predicate on the "leaseExpiresAt" field.
+func LeaseExpiresAtIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLeaseExpiresAt)))
+	})
+}
+
+// LeaseExpiresAtNotNil applies the NotNil predicate on the "leaseExpiresAt" field.
+func LeaseExpiresAtNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLeaseExpiresAt)))
+	})
+}
+
+// ReclaimsEQ applies the EQ predicate on the "reclaims" field.
+func ReclaimsEQ(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReclaims), v))
+	})
+}
+
+// ReclaimsNEQ applies the NEQ predicate on the "reclaims" field.
+func ReclaimsNEQ(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReclaims), v))
+	})
+}
+
+// ReclaimsIn applies the In predicate on the "reclaims" field.
+func ReclaimsIn(vs ...int) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldReclaims), v...))
+	})
+}
+
+// ReclaimsNotIn applies the NotIn predicate on the "reclaims" field.
+func ReclaimsNotIn(vs ...int) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldReclaims), v...))
+	})
+}
+
+// ReclaimsGT applies the GT predicate on the "reclaims" field.
+func ReclaimsGT(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReclaims), v))
+	})
+}
+
+// ReclaimsGTE applies the GTE predicate on the "reclaims" field.
+func ReclaimsGTE(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReclaims), v))
+	})
+}
+
+// ReclaimsLT applies the LT predicate on the "reclaims" field.
+func ReclaimsLT(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReclaims), v))
+	})
+}
+
+// ReclaimsLTE applies the LTE predicate on the "reclaims" field.
+func ReclaimsLTE(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReclaims), v))
+	})
+}
+
+// ShardEQ applies the EQ predicate on the "shard" field.
+func ShardEQ(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldShard), v))
+	})
+}
+
+// ShardNEQ applies the NEQ predicate on the "shard" field.
+func ShardNEQ(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldShard), v))
+	})
+}
+
+// ShardIn applies the In predicate on the "shard" field.
+func ShardIn(vs ...int) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldShard), v...))
+	})
+}
+
+// ShardNotIn applies the NotIn predicate on the "shard" field.
+func ShardNotIn(vs ...int) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldShard), v...))
+	})
+}
+
+// ShardGT applies the GT predicate on the "shard" field.
+func ShardGT(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldShard), v))
+	})
+}
+
+// ShardGTE applies the GTE predicate on the "shard" field.
+func ShardGTE(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldShard), v))
+	})
+}
+
+// ShardLT applies the LT predicate on the "shard" field.
+func ShardLT(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldShard), v))
+	})
+}
+
+// ShardLTE applies the LTE predicate on the "shard" field.
+func ShardLTE(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldShard), v))
+	})
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "maxAttempts" field.
+func MaxAttemptsEQ(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxAttempts), v))
+	})
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "maxAttempts" field.
+func MaxAttemptsNEQ(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMaxAttempts), v))
+	})
+}
+
+// MaxAttemptsIn applies the In predicate on the "maxAttempts" field.
+func MaxAttemptsIn(vs ...int) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldMaxAttempts), v...))
+	})
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "maxAttempts" field.
+func MaxAttemptsNotIn(vs ...int) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldMaxAttempts), v...))
+	})
+}
+
+// MaxAttemptsGT applies the GT predicate on the "maxAttempts" field.
+func MaxAttemptsGT(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMaxAttempts), v))
+	})
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "maxAttempts" field.
+func MaxAttemptsGTE(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMaxAttempts), v))
+	})
+}
+
+// MaxAttemptsLT applies the LT predicate on the "maxAttempts" field.
+func MaxAttemptsLT(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMaxAttempts), v))
+	})
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "maxAttempts" field.
+func MaxAttemptsLTE(v int) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMaxAttempts), v))
+	})
+}
+
+// MaxAttemptsIsNil applies the IsNil predicate on the "maxAttempts" field.
+func MaxAttemptsIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMaxAttempts)))
+	})
+}
+
+// MaxAttemptsNotNil applies the NotNil predicate on the "maxAttempts" field.
+func MaxAttemptsNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMaxAttempts)))
+	})
+}
+
+// RetryBaseDelayMsEQ applies the EQ predicate on the "retryBaseDelayMs" field.
+func RetryBaseDelayMsEQ(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetryBaseDelayMs), v))
+	})
+}
+
+// RetryBaseDelayMsNEQ applies the NEQ predicate on the "retryBaseDelayMs" field.
+func RetryBaseDelayMsNEQ(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRetryBaseDelayMs), v))
+	})
+}
+
+// RetryBaseDelayMsIn applies the In predicate on the "retryBaseDelayMs" field.
+func RetryBaseDelayMsIn(vs ...int64) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldRetryBaseDelayMs), v...))
+	})
+}
+
+// RetryBaseDelayMsNotIn applies the NotIn predicate on the "retryBaseDelayMs" field.
+func RetryBaseDelayMsNotIn(vs ...int64) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldRetryBaseDelayMs), v...))
+	})
+}
+
+// RetryBaseDelayMsGT applies the GT predicate on the "retryBaseDelayMs" field.
+func RetryBaseDelayMsGT(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRetryBaseDelayMs), v))
+	})
+}
+
+// RetryBaseDelayMsGTE applies the GTE predicate on the "retryBaseDelayMs" field.
+func RetryBaseDelayMsGTE(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRetryBaseDelayMs), v))
+	})
+}
+
+// RetryBaseDelayMsLT applies the LT predicate on the "retryBaseDelayMs" field.
+func RetryBaseDelayMsLT(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRetryBaseDelayMs), v))
+	})
+}
+
+// RetryBaseDelayMsLTE applies the LTE predicate on the "retryBaseDelayMs" field.
+func RetryBaseDelayMsLTE(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRetryBaseDelayMs), v))
+	})
+}
+
+// RetryBaseDelayMsIsNil applies the IsNil predicate on the "retryBaseDelayMs" field.
+func RetryBaseDelayMsIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetryBaseDelayMs)))
+	})
+}
+
+// RetryBaseDelayMsNotNil applies the NotNil predicate on the "retryBaseDelayMs" field.
+func RetryBaseDelayMsNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetryBaseDelayMs)))
+	})
+}
+
+// RetryMaxDelayMsEQ applies the EQ predicate on the "retryMaxDelayMs" field.
+func RetryMaxDelayMsEQ(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetryMaxDelayMs), v))
+	})
+}
+
+// RetryMaxDelayMsNEQ applies the NEQ predicate on the "retryMaxDelayMs" field.
+func RetryMaxDelayMsNEQ(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRetryMaxDelayMs), v))
+	})
+}
+
+// RetryMaxDelayMsIn applies the In predicate on the "retryMaxDelayMs" field.
+func RetryMaxDelayMsIn(vs ...int64) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldRetryMaxDelayMs), v...))
+	})
+}
+
+// RetryMaxDelayMsNotIn applies the NotIn predicate on the "retryMaxDelayMs" field.
+func RetryMaxDelayMsNotIn(vs ...int64) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldRetryMaxDelayMs), v...))
+	})
+}
+
+// RetryMaxDelayMsGT applies the GT predicate on the "retryMaxDelayMs" field.
+func RetryMaxDelayMsGT(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRetryMaxDelayMs), v))
+	})
+}
+
+// RetryMaxDelayMsGTE applies the GTE predicate on the "retryMaxDelayMs" field.
+func RetryMaxDelayMsGTE(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRetryMaxDelayMs), v))
+	})
+}
+
+// RetryMaxDelayMsLT applies the LT predicate on the "retryMaxDelayMs" field.
+func RetryMaxDelayMsLT(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRetryMaxDelayMs), v))
+	})
+}
+
+// RetryMaxDelayMsLTE applies the LTE predicate on the "retryMaxDelayMs" field.
+func RetryMaxDelayMsLTE(v int64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRetryMaxDelayMs), v))
+	})
+}
+
+// RetryMaxDelayMsIsNil applies the IsNil predicate on the "retryMaxDelayMs" field.
+func RetryMaxDelayMsIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetryMaxDelayMs)))
+	})
+}
+
+// RetryMaxDelayMsNotNil applies the NotNil predicate on the "retryMaxDelayMs" field.
+func RetryMaxDelayMsNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetryMaxDelayMs)))
+	})
+}
+
+// RetryJitterEQ applies the EQ predicate on the "retryJitter" field.
+func RetryJitterEQ(v float64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetryJitter), v))
+	})
+}
+
+// RetryJitterNEQ applies the NEQ predicate on the "retryJitter" field.
+func RetryJitterNEQ(v float64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRetryJitter), v))
+	})
+}
+
+// RetryJitterIn applies the In predicate on the "retryJitter" field.
+func RetryJitterIn(vs ...float64) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldRetryJitter), v...))
+	})
+}
+
+// RetryJitterNotIn applies the NotIn predicate on the "retryJitter" field.
+func RetryJitterNotIn(vs ...float64) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldRetryJitter), v...))
+	})
+}
+
+// RetryJitterGT applies the GT predicate on the "retryJitter" field.
+func RetryJitterGT(v float64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRetryJitter), v))
+	})
+}
+
+// RetryJitterGTE applies the GTE predicate on the "retryJitter" field.
+func RetryJitterGTE(v float64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRetryJitter), v))
+	})
+}
+
+// RetryJitterLT applies the LT predicate on the "retryJitter" field.
+func RetryJitterLT(v float64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRetryJitter), v))
+	})
+}
+
+// RetryJitterLTE applies the LTE predicate on the "retryJitter" field.
+func RetryJitterLTE(v float64) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRetryJitter), v))
+	})
+}
+
+// RetryJitterIsNil applies the IsNil predicate on the "retryJitter" field.
+func RetryJitterIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetryJitter)))
+	})
+}
+
+// RetryJitterNotNil applies the NotNil predicate on the "retryJitter" field.
+func RetryJitterNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetryJitter)))
+	})
+}
+
+// CallerEQ applies the EQ predicate on the "caller" field.
+func CallerEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCaller), v))
+	})
+}
+
+// CallerNEQ applies the NEQ predicate on the "caller" field.
+func CallerNEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCaller), v))
+	})
+}
+
+// CallerIn applies the In predicate on the "caller" field.
+func CallerIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCaller), v...))
+	})
+}
+
+// CallerNotIn applies the NotIn predicate on the "caller" field.
+func CallerNotIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCaller), v...))
+	})
+}
+
+// CallerGT applies the GT predicate on the "caller" field.
+func CallerGT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCaller), v))
+	})
+}
+
+// CallerGTE applies the GTE predicate on the "caller" field.
+func CallerGTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCaller), v))
+	})
+}
+
+// CallerLT applies the LT predicate on the "caller" field.
+func CallerLT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCaller), v))
+	})
+}
+
+// CallerLTE applies the LTE predicate on the "caller" field.
+func CallerLTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCaller), v))
+	})
+}
+
+// CallerContains applies the Contains predicate on the "caller" field.
+func CallerContains(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCaller), v))
+	})
+}
+
+// CallerHasPrefix applies the HasPrefix predicate on the "caller" field.
+func CallerHasPrefix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCaller), v))
+	})
+}
+
+// CallerHasSuffix applies the HasSuffix predicate on the "caller" field.
+func CallerHasSuffix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCaller), v))
+	})
+}
+
+// CallerEqualFold applies the EqualFold predicate on the "caller" field.
+func CallerEqualFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCaller), v))
+	})
+}
+
+// CallerContainsFold applies the ContainsFold predicate on the "caller" field.
+func CallerContainsFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCaller), v))
+	})
+}
+
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotencyKey" field.
+func IdempotencyKeyEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotencyKey" field.
+func IdempotencyKeyNEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotencyKey" field.
+func IdempotencyKeyIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldIdempotencyKey), v...))
+	})
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotencyKey" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldIdempotencyKey), v...))
+	})
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotencyKey" field.
+func IdempotencyKeyGT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotencyKey" field.
+func IdempotencyKeyGTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotencyKey" field.
+func IdempotencyKeyLT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotencyKey" field.
+func IdempotencyKeyLTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotencyKey" field.
+func IdempotencyKeyContains(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotencyKey" field.
+func IdempotencyKeyHasPrefix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotencyKey" field.
+func IdempotencyKeyHasSuffix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// IdempotencyKeyIsNil applies the IsNil predicate on the "idempotencyKey" field.
+func IdempotencyKeyIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldIdempotencyKey)))
+	})
+}
+
+// IdempotencyKeyNotNil applies the NotNil predicate on the "idempotencyKey" field.
+func IdempotencyKeyNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldIdempotencyKey)))
+	})
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotencyKey" field.
+func IdempotencyKeyEqualFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotencyKey" field.
+func IdempotencyKeyContainsFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldIdempotencyKey), v))
+	})
+}
+
+// RequestHashEQ applies the EQ predicate on the "requestHash" field.
+func RequestHashEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashNEQ applies the NEQ predicate on the "requestHash" field.
+func RequestHashNEQ(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashIn applies the In predicate on the "requestHash" field.
+func RequestHashIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldRequestHash), v...))
+	})
+}
+
+// RequestHashNotIn applies the NotIn predicate on the "requestHash" field.
+func RequestHashNotIn(vs ...string) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldRequestHash), v...))
+	})
+}
+
+// RequestHashGT applies the GT predicate on the "requestHash" field.
+func RequestHashGT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashGTE applies the GTE predicate on the "requestHash" field.
+func RequestHashGTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashLT applies the LT predicate on the "requestHash" field.
+func RequestHashLT(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashLTE applies the LTE predicate on the "requestHash" field.
+func RequestHashLTE(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashContains applies the Contains predicate on the "requestHash" field.
+func RequestHashContains(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashHasPrefix applies the HasPrefix predicate on the "requestHash" field.
+func RequestHashHasPrefix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashHasSuffix applies the HasSuffix predicate on the "requestHash" field.
+func RequestHashHasSuffix(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashIsNil applies the IsNil predicate on the "requestHash" field.
+func RequestHashIsNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRequestHash)))
+	})
+}
+
+// RequestHashNotNil applies the NotNil predicate on the "requestHash" field.
+func RequestHashNotNil() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRequestHash)))
+	})
+}
+
+// RequestHashEqualFold applies the EqualFold predicate on the "requestHash" field.
+func RequestHashEqualFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashContainsFold applies the ContainsFold predicate on the "requestHash" field.
+func RequestHashContainsFold(v string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRequestHash), v))
 	})
 }
 
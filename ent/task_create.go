@@ -6,12 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/Av1shay/timers-scheduler-demo/ent/task"
-	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 )
 
 // TaskCreate is the builder for creating a Task entity.
@@ -33,6 +33,82 @@ func (tc *TaskCreate) SetWebhookUrl(s string) *TaskCreate {
 	return tc
 }
 
+// SetWebhookHost sets the "webhookHost" field.
+func (tc *TaskCreate) SetWebhookHost(s string) *TaskCreate {
+	tc.mutation.SetWebhookHost(s)
+	return tc
+}
+
+// SetNillableWebhookHost sets the "webhookHost" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableWebhookHost(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetWebhookHost(*s)
+	}
+	return tc
+}
+
+// SetMethod sets the "method" field.
+func (tc *TaskCreate) SetMethod(s string) *TaskCreate {
+	tc.mutation.SetMethod(s)
+	return tc
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableMethod(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetMethod(*s)
+	}
+	return tc
+}
+
+// SetHeaders sets the "headers" field.
+func (tc *TaskCreate) SetHeaders(m map[string]string) *TaskCreate {
+	tc.mutation.SetHeaders(m)
+	return tc
+}
+
+// SetBody sets the "body" field.
+func (tc *TaskCreate) SetBody(s string) *TaskCreate {
+	tc.mutation.SetBody(s)
+	return tc
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableBody(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetBody(*s)
+	}
+	return tc
+}
+
+// SetOmitIdSuffix sets the "omitIdSuffix" field.
+func (tc *TaskCreate) SetOmitIdSuffix(b bool) *TaskCreate {
+	tc.mutation.SetOmitIdSuffix(b)
+	return tc
+}
+
+// SetNillableOmitIdSuffix sets the "omitIdSuffix" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableOmitIdSuffix(b *bool) *TaskCreate {
+	if b != nil {
+		tc.SetOmitIdSuffix(*b)
+	}
+	return tc
+}
+
+// SetCron sets the "cron" field.
+func (tc *TaskCreate) SetCron(s string) *TaskCreate {
+	tc.mutation.SetCron(s)
+	return tc
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableCron(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetCron(*s)
+	}
+	return tc
+}
+
 // SetStatus sets the "status" field.
 func (tc *TaskCreate) SetStatus(t task.Status) *TaskCreate {
 	tc.mutation.SetStatus(t)
@@ -47,6 +123,174 @@ func (tc *TaskCreate) SetNillableStatus(t *task.Status) *TaskCreate {
 	return tc
 }
 
+// SetAttempts sets the "attempts" field.
+func (tc *TaskCreate) SetAttempts(i int) *TaskCreate {
+	tc.mutation.SetAttempts(i)
+	return tc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableAttempts(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetAttempts(*i)
+	}
+	return tc
+}
+
+// SetDeliveryId sets the "deliveryId" field.
+func (tc *TaskCreate) SetDeliveryId(s string) *TaskCreate {
+	tc.mutation.SetDeliveryId(s)
+	return tc
+}
+
+// SetNillableDeliveryId sets the "deliveryId" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableDeliveryId(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetDeliveryId(*s)
+	}
+	return tc
+}
+
+// SetLeaseExpiresAt sets the "leaseExpiresAt" field.
+func (tc *TaskCreate) SetLeaseExpiresAt(t time.Time) *TaskCreate {
+	tc.mutation.SetLeaseExpiresAt(t)
+	return tc
+}
+
+// SetNillableLeaseExpiresAt sets the "leaseExpiresAt" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableLeaseExpiresAt(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetLeaseExpiresAt(*t)
+	}
+	return tc
+}
+
+// SetReclaims sets the "reclaims" field.
+func (tc *TaskCreate) SetReclaims(i int) *TaskCreate {
+	tc.mutation.SetReclaims(i)
+	return tc
+}
+
+// SetNillableReclaims sets the "reclaims" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableReclaims(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetReclaims(*i)
+	}
+	return tc
+}
+
+// SetShard sets the "shard" field.
+func (tc *TaskCreate) SetShard(i int) *TaskCreate {
+	tc.mutation.SetShard(i)
+	return tc
+}
+
+// SetNillableShard sets the "shard" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableShard(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetShard(*i)
+	}
+	return tc
+}
+
+// SetMaxAttempts sets the "maxAttempts" field.
+func (tc *TaskCreate) SetMaxAttempts(i int) *TaskCreate {
+	tc.mutation.SetMaxAttempts(i)
+	return tc
+}
+
+// SetNillableMaxAttempts sets the "maxAttempts" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableMaxAttempts(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetMaxAttempts(*i)
+	}
+	return tc
+}
+
+// SetRetryBaseDelayMs sets the "retryBaseDelayMs" field.
+func (tc *TaskCreate) SetRetryBaseDelayMs(i int64) *TaskCreate {
+	tc.mutation.SetRetryBaseDelayMs(i)
+	return tc
+}
+
+// SetNillableRetryBaseDelayMs sets the "retryBaseDelayMs" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRetryBaseDelayMs(i *int64) *TaskCreate {
+	if i != nil {
+		tc.SetRetryBaseDelayMs(*i)
+	}
+	return tc
+}
+
+// SetRetryMaxDelayMs sets the "retryMaxDelayMs" field.
+func (tc *TaskCreate) SetRetryMaxDelayMs(i int64) *TaskCreate {
+	tc.mutation.SetRetryMaxDelayMs(i)
+	return tc
+}
+
+// SetNillableRetryMaxDelayMs sets the "retryMaxDelayMs" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRetryMaxDelayMs(i *int64) *TaskCreate {
+	if i != nil {
+		tc.SetRetryMaxDelayMs(*i)
+	}
+	return tc
+}
+
+// SetRetryJitter sets the "retryJitter" field.
+func (tc *TaskCreate) SetRetryJitter(f float64) *TaskCreate {
+	tc.mutation.SetRetryJitter(f)
+	return tc
+}
+
+// SetNillableRetryJitter sets the "retryJitter" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRetryJitter(f *float64) *TaskCreate {
+	if f != nil {
+		tc.SetRetryJitter(*f)
+	}
+	return tc
+}
+
+// SetCaller sets the "caller" field.
+func (tc *TaskCreate) SetCaller(s string) *TaskCreate {
+	tc.mutation.SetCaller(s)
+	return tc
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableCaller(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetCaller(*s)
+	}
+	return tc
+}
+
+// SetIdempotencyKey sets the "idempotencyKey" field.
+func (tc *TaskCreate) SetIdempotencyKey(s string) *TaskCreate {
+	tc.mutation.SetIdempotencyKey(s)
+	return tc
+}
+
+// SetNillableIdempotencyKey sets the "idempotencyKey" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableIdempotencyKey(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetIdempotencyKey(*s)
+	}
+	return tc
+}
+
+// SetRequestHash sets the "requestHash" field.
+func (tc *TaskCreate) SetRequestHash(s string) *TaskCreate {
+	tc.mutation.SetRequestHash(s)
+	return tc
+}
+
+// SetNillableRequestHash sets the "requestHash" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRequestHash(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetRequestHash(*s)
+	}
+	return tc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tc *TaskCreate) SetCreatedAt(t time.Time) *TaskCreate {
 	tc.mutation.SetCreatedAt(t)
@@ -167,10 +411,38 @@ func (tc *TaskCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (tc *TaskCreate) defaults() {
+	if _, ok := tc.mutation.WebhookHost(); !ok {
+		v := task.DefaultWebhookHost
+		tc.mutation.SetWebhookHost(v)
+	}
+	if _, ok := tc.mutation.Method(); !ok {
+		v := task.DefaultMethod
+		tc.mutation.SetMethod(v)
+	}
+	if _, ok := tc.mutation.OmitIdSuffix(); !ok {
+		v := task.DefaultOmitIdSuffix
+		tc.mutation.SetOmitIdSuffix(v)
+	}
 	if _, ok := tc.mutation.Status(); !ok {
 		v := task.DefaultStatus
 		tc.mutation.SetStatus(v)
 	}
+	if _, ok := tc.mutation.Attempts(); !ok {
+		v := task.DefaultAttempts
+		tc.mutation.SetAttempts(v)
+	}
+	if _, ok := tc.mutation.Reclaims(); !ok {
+		v := task.DefaultReclaims
+		tc.mutation.SetReclaims(v)
+	}
+	if _, ok := tc.mutation.Shard(); !ok {
+		v := task.DefaultShard
+		tc.mutation.SetShard(v)
+	}
+	if _, ok := tc.mutation.Caller(); !ok {
+		v := task.DefaultCaller
+		tc.mutation.SetCaller(v)
+	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		v := task.DefaultCreatedAt()
 		tc.mutation.SetCreatedAt(v)
@@ -189,6 +461,15 @@ func (tc *TaskCreate) check() error {
 	if _, ok := tc.mutation.WebhookUrl(); !ok {
 		return &ValidationError{Name: "webhookUrl", err: errors.New(`ent: missing required field "Task.webhookUrl"`)}
 	}
+	if _, ok := tc.mutation.WebhookHost(); !ok {
+		return &ValidationError{Name: "webhookHost", err: errors.New(`ent: missing required field "Task.webhookHost"`)}
+	}
+	if _, ok := tc.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "Task.method"`)}
+	}
+	if _, ok := tc.mutation.OmitIdSuffix(); !ok {
+		return &ValidationError{Name: "omitIdSuffix", err: errors.New(`ent: missing required field "Task.omitIdSuffix"`)}
+	}
 	if _, ok := tc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Task.status"`)}
 	}
@@ -197,6 +478,18 @@ func (tc *TaskCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Task.attempts"`)}
+	}
+	if _, ok := tc.mutation.Reclaims(); !ok {
+		return &ValidationError{Name: "reclaims", err: errors.New(`ent: missing required field "Task.reclaims"`)}
+	}
+	if _, ok := tc.mutation.Shard(); !ok {
+		return &ValidationError{Name: "shard", err: errors.New(`ent: missing required field "Task.shard"`)}
+	}
+	if _, ok := tc.mutation.Caller(); !ok {
+		return &ValidationError{Name: "caller", err: errors.New(`ent: missing required field "Task.caller"`)}
+	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Task.created_at"`)}
 	}
@@ -238,10 +531,82 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldWebhookUrl, field.TypeString, value)
 		_node.WebhookUrl = value
 	}
+	if value, ok := tc.mutation.WebhookHost(); ok {
+		_spec.SetField(task.FieldWebhookHost, field.TypeString, value)
+		_node.WebhookHost = value
+	}
+	if value, ok := tc.mutation.Method(); ok {
+		_spec.SetField(task.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := tc.mutation.Headers(); ok {
+		_spec.SetField(task.FieldHeaders, field.TypeJSON, value)
+		_node.Headers = value
+	}
+	if value, ok := tc.mutation.Body(); ok {
+		_spec.SetField(task.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := tc.mutation.OmitIdSuffix(); ok {
+		_spec.SetField(task.FieldOmitIdSuffix, field.TypeBool, value)
+		_node.OmitIdSuffix = value
+	}
+	if value, ok := tc.mutation.Cron(); ok {
+		_spec.SetField(task.FieldCron, field.TypeString, value)
+		_node.Cron = value
+	}
 	if value, ok := tc.mutation.Status(); ok {
 		_spec.SetField(task.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := tc.mutation.Attempts(); ok {
+		_spec.SetField(task.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := tc.mutation.DeliveryId(); ok {
+		_spec.SetField(task.FieldDeliveryId, field.TypeString, value)
+		_node.DeliveryId = value
+	}
+	if value, ok := tc.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(task.FieldLeaseExpiresAt, field.TypeTime, value)
+		_node.LeaseExpiresAt = &value
+	}
+	if value, ok := tc.mutation.Reclaims(); ok {
+		_spec.SetField(task.FieldReclaims, field.TypeInt, value)
+		_node.Reclaims = value
+	}
+	if value, ok := tc.mutation.Shard(); ok {
+		_spec.SetField(task.FieldShard, field.TypeInt, value)
+		_node.Shard = value
+	}
+	if value, ok := tc.mutation.MaxAttempts(); ok {
+		_spec.SetField(task.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = &value
+	}
+	if value, ok := tc.mutation.RetryBaseDelayMs(); ok {
+		_spec.SetField(task.FieldRetryBaseDelayMs, field.TypeInt64, value)
+		_node.RetryBaseDelayMs = &value
+	}
+	if value, ok := tc.mutation.RetryMaxDelayMs(); ok {
+		_spec.SetField(task.FieldRetryMaxDelayMs, field.TypeInt64, value)
+		_node.RetryMaxDelayMs = &value
+	}
+	if value, ok := tc.mutation.RetryJitter(); ok {
+		_spec.SetField(task.FieldRetryJitter, field.TypeFloat64, value)
+		_node.RetryJitter = &value
+	}
+	if value, ok := tc.mutation.Caller(); ok {
+		_spec.SetField(task.FieldCaller, field.TypeString, value)
+		_node.Caller = value
+	}
+	if value, ok := tc.mutation.IdempotencyKey(); ok {
+		_spec.SetField(task.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = &value
+	}
+	if value, ok := tc.mutation.RequestHash(); ok {
+		_spec.SetField(task.FieldRequestHash, field.TypeString, value)
+		_node.RequestHash = value
+	}
 	if value, ok := tc.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -5,12 +5,12 @@ package ent
 import (
 	"context"
 	"fmt"
-	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
-	"github.com/Av1shay/timers-scheduler-demo/ent/task"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
 )
 
 // TaskDelete is the builder for deleting a Task entity.
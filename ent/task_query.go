@@ -7,13 +7,14 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
-	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
-	"github.com/Av1shay/timers-scheduler-demo/ent/task"
-	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 )
 
 // TaskQuery is the builder for querying Task entities.
@@ -26,6 +27,7 @@ type TaskQuery struct {
 	fields        []string
 	predicates    []predicate.Task
 	withHistories *TaskHistoryQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -298,7 +300,6 @@ func (tq *TaskQuery) WithHistories(opts ...func(*TaskHistoryQuery)) *TaskQuery {
 //		GroupBy(task.FieldDueDate).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (tq *TaskQuery) GroupBy(field string, fields ...string) *TaskGroupBy {
 	grbuild := &TaskGroupBy{config: tq.config}
 	grbuild.fields = append([]string{field}, fields...)
@@ -325,7 +326,6 @@ func (tq *TaskQuery) GroupBy(field string, fields ...string) *TaskGroupBy {
 //	client.Task.Query().
 //		Select(task.FieldDueDate).
 //		Scan(ctx, &v)
-//
 func (tq *TaskQuery) Select(fields ...string) *TaskSelect {
 	tq.fields = append(tq.fields, fields...)
 	selbuild := &TaskSelect{TaskQuery: tq}
@@ -372,6 +372,9 @@ func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(tq.modifiers) > 0 {
+		_spec.Modifiers = tq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -425,6 +428,9 @@ func (tq *TaskQuery) loadHistories(ctx context.Context, query *TaskHistoryQuery,
 
 func (tq *TaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
+	if len(tq.modifiers) > 0 {
+		_spec.Modifiers = tq.modifiers
+	}
 	_spec.Node.Columns = tq.fields
 	if len(tq.fields) > 0 {
 		_spec.Unique = tq.unique != nil && *tq.unique
@@ -506,6 +512,9 @@ func (tq *TaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if tq.unique != nil && *tq.unique {
 		selector.Distinct()
 	}
+	for _, m := range tq.modifiers {
+		m(selector)
+	}
 	for _, p := range tq.predicates {
 		p(selector)
 	}
@@ -523,6 +532,32 @@ func (tq *TaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tq *TaskQuery) ForUpdate(opts ...sql.LockOption) *TaskQuery {
+	if tq.driver.Dialect() == dialect.Postgres {
+		tq.Unique(false)
+	}
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tq *TaskQuery) ForShare(opts ...sql.LockOption) *TaskQuery {
+	if tq.driver.Dialect() == dialect.Postgres {
+		tq.Unique(false)
+	}
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tq
+}
+
 // TaskGroupBy is the group-by builder for Task entities.
 type TaskGroupBy struct {
 	config
@@ -6,14 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
-	"github.com/Av1shay/timers-scheduler-demo/ent/task"
-	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 )
 
 // TaskUpdate is the builder for updating Task entities.
@@ -41,6 +41,100 @@ func (tu *TaskUpdate) SetWebhookUrl(s string) *TaskUpdate {
 	return tu
 }
 
+// SetWebhookHost sets the "webhookHost" field.
+func (tu *TaskUpdate) SetWebhookHost(s string) *TaskUpdate {
+	tu.mutation.SetWebhookHost(s)
+	return tu
+}
+
+// SetNillableWebhookHost sets the "webhookHost" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableWebhookHost(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetWebhookHost(*s)
+	}
+	return tu
+}
+
+// SetMethod sets the "method" field.
+func (tu *TaskUpdate) SetMethod(s string) *TaskUpdate {
+	tu.mutation.SetMethod(s)
+	return tu
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableMethod(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetMethod(*s)
+	}
+	return tu
+}
+
+// SetHeaders sets the "headers" field.
+func (tu *TaskUpdate) SetHeaders(m map[string]string) *TaskUpdate {
+	tu.mutation.SetHeaders(m)
+	return tu
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (tu *TaskUpdate) ClearHeaders() *TaskUpdate {
+	tu.mutation.ClearHeaders()
+	return tu
+}
+
+// SetBody sets the "body" field.
+func (tu *TaskUpdate) SetBody(s string) *TaskUpdate {
+	tu.mutation.SetBody(s)
+	return tu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableBody(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetBody(*s)
+	}
+	return tu
+}
+
+// ClearBody clears the value of the "body" field.
+func (tu *TaskUpdate) ClearBody() *TaskUpdate {
+	tu.mutation.ClearBody()
+	return tu
+}
+
+// SetOmitIdSuffix sets the "omitIdSuffix" field.
+func (tu *TaskUpdate) SetOmitIdSuffix(b bool) *TaskUpdate {
+	tu.mutation.SetOmitIdSuffix(b)
+	return tu
+}
+
+// SetNillableOmitIdSuffix sets the "omitIdSuffix" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableOmitIdSuffix(b *bool) *TaskUpdate {
+	if b != nil {
+		tu.SetOmitIdSuffix(*b)
+	}
+	return tu
+}
+
+// SetCron sets the "cron" field.
+func (tu *TaskUpdate) SetCron(s string) *TaskUpdate {
+	tu.mutation.SetCron(s)
+	return tu
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableCron(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetCron(*s)
+	}
+	return tu
+}
+
+// ClearCron clears the value of the "cron" field.
+func (tu *TaskUpdate) ClearCron() *TaskUpdate {
+	tu.mutation.ClearCron()
+	return tu
+}
+
 // SetStatus sets the "status" field.
 func (tu *TaskUpdate) SetStatus(t task.Status) *TaskUpdate {
 	tu.mutation.SetStatus(t)
@@ -55,6 +149,271 @@ func (tu *TaskUpdate) SetNillableStatus(t *task.Status) *TaskUpdate {
 	return tu
 }
 
+// SetAttempts sets the "attempts" field.
+func (tu *TaskUpdate) SetAttempts(i int) *TaskUpdate {
+	tu.mutation.ResetAttempts()
+	tu.mutation.SetAttempts(i)
+	return tu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableAttempts(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetAttempts(*i)
+	}
+	return tu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (tu *TaskUpdate) AddAttempts(i int) *TaskUpdate {
+	tu.mutation.AddAttempts(i)
+	return tu
+}
+
+// SetDeliveryId sets the "deliveryId" field.
+func (tu *TaskUpdate) SetDeliveryId(s string) *TaskUpdate {
+	tu.mutation.SetDeliveryId(s)
+	return tu
+}
+
+// SetNillableDeliveryId sets the "deliveryId" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableDeliveryId(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetDeliveryId(*s)
+	}
+	return tu
+}
+
+// ClearDeliveryId clears the value of the "deliveryId" field.
+func (tu *TaskUpdate) ClearDeliveryId() *TaskUpdate {
+	tu.mutation.ClearDeliveryId()
+	return tu
+}
+
+// SetLeaseExpiresAt sets the "leaseExpiresAt" field.
+func (tu *TaskUpdate) SetLeaseExpiresAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetLeaseExpiresAt(t)
+	return tu
+}
+
+// SetNillableLeaseExpiresAt sets the "leaseExpiresAt" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableLeaseExpiresAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetLeaseExpiresAt(*t)
+	}
+	return tu
+}
+
+// ClearLeaseExpiresAt clears the value of the "leaseExpiresAt" field.
+func (tu *TaskUpdate) ClearLeaseExpiresAt() *TaskUpdate {
+	tu.mutation.ClearLeaseExpiresAt()
+	return tu
+}
+
+// SetReclaims sets the "reclaims" field.
+func (tu *TaskUpdate) SetReclaims(i int) *TaskUpdate {
+	tu.mutation.ResetReclaims()
+	tu.mutation.SetReclaims(i)
+	return tu
+}
+
+// SetNillableReclaims sets the "reclaims" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableReclaims(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetReclaims(*i)
+	}
+	return tu
+}
+
+// AddReclaims adds i to the "reclaims" field.
+func (tu *TaskUpdate) AddReclaims(i int) *TaskUpdate {
+	tu.mutation.AddReclaims(i)
+	return tu
+}
+
+// SetShard sets the "shard" field.
+func (tu *TaskUpdate) SetShard(i int) *TaskUpdate {
+	tu.mutation.ResetShard()
+	tu.mutation.SetShard(i)
+	return tu
+}
+
+// SetNillableShard sets the "shard" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableShard(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetShard(*i)
+	}
+	return tu
+}
+
+// AddShard adds i to the "shard" field.
+func (tu *TaskUpdate) AddShard(i int) *TaskUpdate {
+	tu.mutation.AddShard(i)
+	return tu
+}
+
+// SetMaxAttempts sets the "maxAttempts" field.
+func (tu *TaskUpdate) SetMaxAttempts(i int) *TaskUpdate {
+	tu.mutation.ResetMaxAttempts()
+	tu.mutation.SetMaxAttempts(i)
+	return tu
+}
+
+// SetNillableMaxAttempts sets the "maxAttempts" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableMaxAttempts(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetMaxAttempts(*i)
+	}
+	return tu
+}
+
+// AddMaxAttempts adds i to the "maxAttempts" field.
+func (tu *TaskUpdate) AddMaxAttempts(i int) *TaskUpdate {
+	tu.mutation.AddMaxAttempts(i)
+	return tu
+}
+
+// ClearMaxAttempts clears the value of the "maxAttempts" field.
+func (tu *TaskUpdate) ClearMaxAttempts() *TaskUpdate {
+	tu.mutation.ClearMaxAttempts()
+	return tu
+}
+
+// SetRetryBaseDelayMs sets the "retryBaseDelayMs" field.
+func (tu *TaskUpdate) SetRetryBaseDelayMs(i int64) *TaskUpdate {
+	tu.mutation.ResetRetryBaseDelayMs()
+	tu.mutation.SetRetryBaseDelayMs(i)
+	return tu
+}
+
+// SetNillableRetryBaseDelayMs sets the "retryBaseDelayMs" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRetryBaseDelayMs(i *int64) *TaskUpdate {
+	if i != nil {
+		tu.SetRetryBaseDelayMs(*i)
+	}
+	return tu
+}
+
+// AddRetryBaseDelayMs adds i to the "retryBaseDelayMs" field.
+func (tu *TaskUpdate) AddRetryBaseDelayMs(i int64) *TaskUpdate {
+	tu.mutation.AddRetryBaseDelayMs(i)
+	return tu
+}
+
+// ClearRetryBaseDelayMs clears the value of the "retryBaseDelayMs" field.
+func (tu *TaskUpdate) ClearRetryBaseDelayMs() *TaskUpdate {
+	tu.mutation.ClearRetryBaseDelayMs()
+	return tu
+}
+
+// SetRetryMaxDelayMs sets the "retryMaxDelayMs" field.
+func (tu *TaskUpdate) SetRetryMaxDelayMs(i int64) *TaskUpdate {
+	tu.mutation.ResetRetryMaxDelayMs()
+	tu.mutation.SetRetryMaxDelayMs(i)
+	return tu
+}
+
+// SetNillableRetryMaxDelayMs sets the "retryMaxDelayMs" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRetryMaxDelayMs(i *int64) *TaskUpdate {
+	if i != nil {
+		tu.SetRetryMaxDelayMs(*i)
+	}
+	return tu
+}
+
+// AddRetryMaxDelayMs adds i to the "retryMaxDelayMs" field.
+func (tu *TaskUpdate) AddRetryMaxDelayMs(i int64) *TaskUpdate {
+	tu.mutation.AddRetryMaxDelayMs(i)
+	return tu
+}
+
+// ClearRetryMaxDelayMs clears the value of the "retryMaxDelayMs" field.
+func (tu *TaskUpdate) ClearRetryMaxDelayMs() *TaskUpdate {
+	tu.mutation.ClearRetryMaxDelayMs()
+	return tu
+}
+
+// SetRetryJitter sets the "retryJitter" field.
+func (tu *TaskUpdate) SetRetryJitter(f float64) *TaskUpdate {
+	tu.mutation.ResetRetryJitter()
+	tu.mutation.SetRetryJitter(f)
+	return tu
+}
+
+// SetNillableRetryJitter sets the "retryJitter" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRetryJitter(f *float64) *TaskUpdate {
+	if f != nil {
+		tu.SetRetryJitter(*f)
+	}
+	return tu
+}
+
+// AddRetryJitter adds f to the "retryJitter" field.
+func (tu *TaskUpdate) AddRetryJitter(f float64) *TaskUpdate {
+	tu.mutation.AddRetryJitter(f)
+	return tu
+}
+
+// ClearRetryJitter clears the value of the "retryJitter" field.
+func (tu *TaskUpdate) ClearRetryJitter() *TaskUpdate {
+	tu.mutation.ClearRetryJitter()
+	return tu
+}
+
+// SetCaller sets the "caller" field.
+func (tu *TaskUpdate) SetCaller(s string) *TaskUpdate {
+	tu.mutation.SetCaller(s)
+	return tu
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableCaller(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetCaller(*s)
+	}
+	return tu
+}
+
+// SetIdempotencyKey sets the "idempotencyKey" field.
+func (tu *TaskUpdate) SetIdempotencyKey(s string) *TaskUpdate {
+	tu.mutation.SetIdempotencyKey(s)
+	return tu
+}
+
+// SetNillableIdempotencyKey sets the "idempotencyKey" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableIdempotencyKey(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetIdempotencyKey(*s)
+	}
+	return tu
+}
+
+// ClearIdempotencyKey clears the value of the "idempotencyKey" field.
+func (tu *TaskUpdate) ClearIdempotencyKey() *TaskUpdate {
+	tu.mutation.ClearIdempotencyKey()
+	return tu
+}
+
+// SetRequestHash sets the "requestHash" field.
+func (tu *TaskUpdate) SetRequestHash(s string) *TaskUpdate {
+	tu.mutation.SetRequestHash(s)
+	return tu
+}
+
+// SetNillableRequestHash sets the "requestHash" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRequestHash(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetRequestHash(*s)
+	}
+	return tu
+}
+
+// ClearRequestHash clears the value of the "requestHash" field.
+func (tu *TaskUpdate) ClearRequestHash() *TaskUpdate {
+	tu.mutation.ClearRequestHash()
+	return tu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tu *TaskUpdate) SetCreatedAt(t time.Time) *TaskUpdate {
 	tu.mutation.SetCreatedAt(t)
@@ -219,9 +578,117 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.WebhookUrl(); ok {
 		_spec.SetField(task.FieldWebhookUrl, field.TypeString, value)
 	}
+	if value, ok := tu.mutation.WebhookHost(); ok {
+		_spec.SetField(task.FieldWebhookHost, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Method(); ok {
+		_spec.SetField(task.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Headers(); ok {
+		_spec.SetField(task.FieldHeaders, field.TypeJSON, value)
+	}
+	if tu.mutation.HeadersCleared() {
+		_spec.ClearField(task.FieldHeaders, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.Body(); ok {
+		_spec.SetField(task.FieldBody, field.TypeString, value)
+	}
+	if tu.mutation.BodyCleared() {
+		_spec.ClearField(task.FieldBody, field.TypeString)
+	}
+	if value, ok := tu.mutation.OmitIdSuffix(); ok {
+		_spec.SetField(task.FieldOmitIdSuffix, field.TypeBool, value)
+	}
+	if value, ok := tu.mutation.Cron(); ok {
+		_spec.SetField(task.FieldCron, field.TypeString, value)
+	}
+	if tu.mutation.CronCleared() {
+		_spec.ClearField(task.FieldCron, field.TypeString)
+	}
 	if value, ok := tu.mutation.Status(); ok {
 		_spec.SetField(task.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := tu.mutation.Attempts(); ok {
+		_spec.SetField(task.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedAttempts(); ok {
+		_spec.AddField(task.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.DeliveryId(); ok {
+		_spec.SetField(task.FieldDeliveryId, field.TypeString, value)
+	}
+	if tu.mutation.DeliveryIdCleared() {
+		_spec.ClearField(task.FieldDeliveryId, field.TypeString)
+	}
+	if value, ok := tu.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(task.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if tu.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(task.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.Reclaims(); ok {
+		_spec.SetField(task.FieldReclaims, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedReclaims(); ok {
+		_spec.AddField(task.FieldReclaims, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.Shard(); ok {
+		_spec.SetField(task.FieldShard, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedShard(); ok {
+		_spec.AddField(task.FieldShard, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.MaxAttempts(); ok {
+		_spec.SetField(task.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(task.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if tu.mutation.MaxAttemptsCleared() {
+		_spec.ClearField(task.FieldMaxAttempts, field.TypeInt)
+	}
+	if value, ok := tu.mutation.RetryBaseDelayMs(); ok {
+		_spec.SetField(task.FieldRetryBaseDelayMs, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedRetryBaseDelayMs(); ok {
+		_spec.AddField(task.FieldRetryBaseDelayMs, field.TypeInt64, value)
+	}
+	if tu.mutation.RetryBaseDelayMsCleared() {
+		_spec.ClearField(task.FieldRetryBaseDelayMs, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.RetryMaxDelayMs(); ok {
+		_spec.SetField(task.FieldRetryMaxDelayMs, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedRetryMaxDelayMs(); ok {
+		_spec.AddField(task.FieldRetryMaxDelayMs, field.TypeInt64, value)
+	}
+	if tu.mutation.RetryMaxDelayMsCleared() {
+		_spec.ClearField(task.FieldRetryMaxDelayMs, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.RetryJitter(); ok {
+		_spec.SetField(task.FieldRetryJitter, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedRetryJitter(); ok {
+		_spec.AddField(task.FieldRetryJitter, field.TypeFloat64, value)
+	}
+	if tu.mutation.RetryJitterCleared() {
+		_spec.ClearField(task.FieldRetryJitter, field.TypeFloat64)
+	}
+	if value, ok := tu.mutation.Caller(); ok {
+		_spec.SetField(task.FieldCaller, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.IdempotencyKey(); ok {
+		_spec.SetField(task.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if tu.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(task.FieldIdempotencyKey, field.TypeString)
+	}
+	if value, ok := tu.mutation.RequestHash(); ok {
+		_spec.SetField(task.FieldRequestHash, field.TypeString, value)
+	}
+	if tu.mutation.RequestHashCleared() {
+		_spec.ClearField(task.FieldRequestHash, field.TypeString)
+	}
 	if value, ok := tu.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -313,6 +780,100 @@ func (tuo *TaskUpdateOne) SetWebhookUrl(s string) *TaskUpdateOne {
 	return tuo
 }
 
+// SetWebhookHost sets the "webhookHost" field.
+func (tuo *TaskUpdateOne) SetWebhookHost(s string) *TaskUpdateOne {
+	tuo.mutation.SetWebhookHost(s)
+	return tuo
+}
+
+// SetNillableWebhookHost sets the "webhookHost" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableWebhookHost(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetWebhookHost(*s)
+	}
+	return tuo
+}
+
+// SetMethod sets the "method" field.
+func (tuo *TaskUpdateOne) SetMethod(s string) *TaskUpdateOne {
+	tuo.mutation.SetMethod(s)
+	return tuo
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableMethod(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetMethod(*s)
+	}
+	return tuo
+}
+
+// SetHeaders sets the "headers" field.
+func (tuo *TaskUpdateOne) SetHeaders(m map[string]string) *TaskUpdateOne {
+	tuo.mutation.SetHeaders(m)
+	return tuo
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (tuo *TaskUpdateOne) ClearHeaders() *TaskUpdateOne {
+	tuo.mutation.ClearHeaders()
+	return tuo
+}
+
+// SetBody sets the "body" field.
+func (tuo *TaskUpdateOne) SetBody(s string) *TaskUpdateOne {
+	tuo.mutation.SetBody(s)
+	return tuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableBody(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetBody(*s)
+	}
+	return tuo
+}
+
+// ClearBody clears the value of the "body" field.
+func (tuo *TaskUpdateOne) ClearBody() *TaskUpdateOne {
+	tuo.mutation.ClearBody()
+	return tuo
+}
+
+// SetOmitIdSuffix sets the "omitIdSuffix" field.
+func (tuo *TaskUpdateOne) SetOmitIdSuffix(b bool) *TaskUpdateOne {
+	tuo.mutation.SetOmitIdSuffix(b)
+	return tuo
+}
+
+// SetNillableOmitIdSuffix sets the "omitIdSuffix" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableOmitIdSuffix(b *bool) *TaskUpdateOne {
+	if b != nil {
+		tuo.SetOmitIdSuffix(*b)
+	}
+	return tuo
+}
+
+// SetCron sets the "cron" field.
+func (tuo *TaskUpdateOne) SetCron(s string) *TaskUpdateOne {
+	tuo.mutation.SetCron(s)
+	return tuo
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCron(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetCron(*s)
+	}
+	return tuo
+}
+
+// ClearCron clears the value of the "cron" field.
+func (tuo *TaskUpdateOne) ClearCron() *TaskUpdateOne {
+	tuo.mutation.ClearCron()
+	return tuo
+}
+
 // SetStatus sets the "status" field.
 func (tuo *TaskUpdateOne) SetStatus(t task.Status) *TaskUpdateOne {
 	tuo.mutation.SetStatus(t)
@@ -327,6 +888,271 @@ func (tuo *TaskUpdateOne) SetNillableStatus(t *task.Status) *TaskUpdateOne {
 	return tuo
 }
 
+// SetAttempts sets the "attempts" field.
+func (tuo *TaskUpdateOne) SetAttempts(i int) *TaskUpdateOne {
+	tuo.mutation.ResetAttempts()
+	tuo.mutation.SetAttempts(i)
+	return tuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableAttempts(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetAttempts(*i)
+	}
+	return tuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (tuo *TaskUpdateOne) AddAttempts(i int) *TaskUpdateOne {
+	tuo.mutation.AddAttempts(i)
+	return tuo
+}
+
+// SetDeliveryId sets the "deliveryId" field.
+func (tuo *TaskUpdateOne) SetDeliveryId(s string) *TaskUpdateOne {
+	tuo.mutation.SetDeliveryId(s)
+	return tuo
+}
+
+// SetNillableDeliveryId sets the "deliveryId" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDeliveryId(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetDeliveryId(*s)
+	}
+	return tuo
+}
+
+// ClearDeliveryId clears the value of the "deliveryId" field.
+func (tuo *TaskUpdateOne) ClearDeliveryId() *TaskUpdateOne {
+	tuo.mutation.ClearDeliveryId()
+	return tuo
+}
+
+// SetLeaseExpiresAt sets the "leaseExpiresAt" field.
+func (tuo *TaskUpdateOne) SetLeaseExpiresAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetLeaseExpiresAt(t)
+	return tuo
+}
+
+// SetNillableLeaseExpiresAt sets the "leaseExpiresAt" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableLeaseExpiresAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetLeaseExpiresAt(*t)
+	}
+	return tuo
+}
+
+// ClearLeaseExpiresAt clears the value of the "leaseExpiresAt" field.
+func (tuo *TaskUpdateOne) ClearLeaseExpiresAt() *TaskUpdateOne {
+	tuo.mutation.ClearLeaseExpiresAt()
+	return tuo
+}
+
+// SetReclaims sets the "reclaims" field.
+func (tuo *TaskUpdateOne) SetReclaims(i int) *TaskUpdateOne {
+	tuo.mutation.ResetReclaims()
+	tuo.mutation.SetReclaims(i)
+	return tuo
+}
+
+// SetNillableReclaims sets the "reclaims" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableReclaims(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetReclaims(*i)
+	}
+	return tuo
+}
+
+// AddReclaims adds i to the "reclaims" field.
+func (tuo *TaskUpdateOne) AddReclaims(i int) *TaskUpdateOne {
+	tuo.mutation.AddReclaims(i)
+	return tuo
+}
+
+// SetShard sets the "shard" field.
+func (tuo *TaskUpdateOne) SetShard(i int) *TaskUpdateOne {
+	tuo.mutation.ResetShard()
+	tuo.mutation.SetShard(i)
+	return tuo
+}
+
+// SetNillableShard sets the "shard" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableShard(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetShard(*i)
+	}
+	return tuo
+}
+
+// AddShard adds i to the "shard" field.
+func (tuo *TaskUpdateOne) AddShard(i int) *TaskUpdateOne {
+	tuo.mutation.AddShard(i)
+	return tuo
+}
+
+// SetMaxAttempts sets the "maxAttempts" field.
+func (tuo *TaskUpdateOne) SetMaxAttempts(i int) *TaskUpdateOne {
+	tuo.mutation.ResetMaxAttempts()
+	tuo.mutation.SetMaxAttempts(i)
+	return tuo
+}
+
+// SetNillableMaxAttempts sets the "maxAttempts" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableMaxAttempts(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetMaxAttempts(*i)
+	}
+	return tuo
+}
+
+// AddMaxAttempts adds i to the "maxAttempts" field.
+func (tuo *TaskUpdateOne) AddMaxAttempts(i int) *TaskUpdateOne {
+	tuo.mutation.AddMaxAttempts(i)
+	return tuo
+}
+
+// ClearMaxAttempts clears the value of the "maxAttempts" field.
+func (tuo *TaskUpdateOne) ClearMaxAttempts() *TaskUpdateOne {
+	tuo.mutation.ClearMaxAttempts()
+	return tuo
+}
+
+// SetRetryBaseDelayMs sets the "retryBaseDelayMs" field.
+func (tuo *TaskUpdateOne) SetRetryBaseDelayMs(i int64) *TaskUpdateOne {
+	tuo.mutation.ResetRetryBaseDelayMs()
+	tuo.mutation.SetRetryBaseDelayMs(i)
+	return tuo
+}
+
+// SetNillableRetryBaseDelayMs sets the "retryBaseDelayMs" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRetryBaseDelayMs(i *int64) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetRetryBaseDelayMs(*i)
+	}
+	return tuo
+}
+
+// AddRetryBaseDelayMs adds i to the "retryBaseDelayMs" field.
+func (tuo *TaskUpdateOne) AddRetryBaseDelayMs(i int64) *TaskUpdateOne {
+	tuo.mutation.AddRetryBaseDelayMs(i)
+	return tuo
+}
+
+// ClearRetryBaseDelayMs clears the value of the "retryBaseDelayMs" field.
+func (tuo *TaskUpdateOne) ClearRetryBaseDelayMs() *TaskUpdateOne {
+	tuo.mutation.ClearRetryBaseDelayMs()
+	return tuo
+}
+
+// SetRetryMaxDelayMs sets the "retryMaxDelayMs" field.
+func (tuo *TaskUpdateOne) SetRetryMaxDelayMs(i int64) *TaskUpdateOne {
+	tuo.mutation.ResetRetryMaxDelayMs()
+	tuo.mutation.SetRetryMaxDelayMs(i)
+	return tuo
+}
+
+// SetNillableRetryMaxDelayMs sets the "retryMaxDelayMs" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRetryMaxDelayMs(i *int64) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetRetryMaxDelayMs(*i)
+	}
+	return tuo
+}
+
+// AddRetryMaxDelayMs adds i to the "retryMaxDelayMs" field.
+func (tuo *TaskUpdateOne) AddRetryMaxDelayMs(i int64) *TaskUpdateOne {
+	tuo.mutation.AddRetryMaxDelayMs(i)
+	return tuo
+}
+
+// ClearRetryMaxDelayMs clears the value of the "retryMaxDelayMs" field.
+func (tuo *TaskUpdateOne) ClearRetryMaxDelayMs() *TaskUpdateOne {
+	tuo.mutation.ClearRetryMaxDelayMs()
+	return tuo
+}
+
+// SetRetryJitter sets the "retryJitter" field.
+func (tuo *TaskUpdateOne) SetRetryJitter(f float64) *TaskUpdateOne {
+	tuo.mutation.ResetRetryJitter()
+	tuo.mutation.SetRetryJitter(f)
+	return tuo
+}
+
+// SetNillableRetryJitter sets the "retryJitter" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRetryJitter(f *float64) *TaskUpdateOne {
+	if f != nil {
+		tuo.SetRetryJitter(*f)
+	}
+	return tuo
+}
+
+// AddRetryJitter adds f to the "retryJitter" field.
+func (tuo *TaskUpdateOne) AddRetryJitter(f float64) *TaskUpdateOne {
+	tuo.mutation.AddRetryJitter(f)
+	return tuo
+}
+
+// ClearRetryJitter clears the value of the "retryJitter" field.
+func (tuo *TaskUpdateOne) ClearRetryJitter() *TaskUpdateOne {
+	tuo.mutation.ClearRetryJitter()
+	return tuo
+}
+
+// SetCaller sets the "caller" field.
+func (tuo *TaskUpdateOne) SetCaller(s string) *TaskUpdateOne {
+	tuo.mutation.SetCaller(s)
+	return tuo
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCaller(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetCaller(*s)
+	}
+	return tuo
+}
+
+// SetIdempotencyKey sets the "idempotencyKey" field.
+func (tuo *TaskUpdateOne) SetIdempotencyKey(s string) *TaskUpdateOne {
+	tuo.mutation.SetIdempotencyKey(s)
+	return tuo
+}
+
+// SetNillableIdempotencyKey sets the "idempotencyKey" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableIdempotencyKey(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetIdempotencyKey(*s)
+	}
+	return tuo
+}
+
+// ClearIdempotencyKey clears the value of the "idempotencyKey" field.
+func (tuo *TaskUpdateOne) ClearIdempotencyKey() *TaskUpdateOne {
+	tuo.mutation.ClearIdempotencyKey()
+	return tuo
+}
+
+// SetRequestHash sets the "requestHash" field.
+func (tuo *TaskUpdateOne) SetRequestHash(s string) *TaskUpdateOne {
+	tuo.mutation.SetRequestHash(s)
+	return tuo
+}
+
+// SetNillableRequestHash sets the "requestHash" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRequestHash(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetRequestHash(*s)
+	}
+	return tuo
+}
+
+// ClearRequestHash clears the value of the "requestHash" field.
+func (tuo *TaskUpdateOne) ClearRequestHash() *TaskUpdateOne {
+	tuo.mutation.ClearRequestHash()
+	return tuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tuo *TaskUpdateOne) SetCreatedAt(t time.Time) *TaskUpdateOne {
 	tuo.mutation.SetCreatedAt(t)
@@ -521,9 +1347,117 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if value, ok := tuo.mutation.WebhookUrl(); ok {
 		_spec.SetField(task.FieldWebhookUrl, field.TypeString, value)
 	}
+	if value, ok := tuo.mutation.WebhookHost(); ok {
+		_spec.SetField(task.FieldWebhookHost, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Method(); ok {
+		_spec.SetField(task.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Headers(); ok {
+		_spec.SetField(task.FieldHeaders, field.TypeJSON, value)
+	}
+	if tuo.mutation.HeadersCleared() {
+		_spec.ClearField(task.FieldHeaders, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.Body(); ok {
+		_spec.SetField(task.FieldBody, field.TypeString, value)
+	}
+	if tuo.mutation.BodyCleared() {
+		_spec.ClearField(task.FieldBody, field.TypeString)
+	}
+	if value, ok := tuo.mutation.OmitIdSuffix(); ok {
+		_spec.SetField(task.FieldOmitIdSuffix, field.TypeBool, value)
+	}
+	if value, ok := tuo.mutation.Cron(); ok {
+		_spec.SetField(task.FieldCron, field.TypeString, value)
+	}
+	if tuo.mutation.CronCleared() {
+		_spec.ClearField(task.FieldCron, field.TypeString)
+	}
 	if value, ok := tuo.mutation.Status(); ok {
 		_spec.SetField(task.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := tuo.mutation.Attempts(); ok {
+		_spec.SetField(task.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(task.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.DeliveryId(); ok {
+		_spec.SetField(task.FieldDeliveryId, field.TypeString, value)
+	}
+	if tuo.mutation.DeliveryIdCleared() {
+		_spec.ClearField(task.FieldDeliveryId, field.TypeString)
+	}
+	if value, ok := tuo.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(task.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if tuo.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(task.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.Reclaims(); ok {
+		_spec.SetField(task.FieldReclaims, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedReclaims(); ok {
+		_spec.AddField(task.FieldReclaims, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.Shard(); ok {
+		_spec.SetField(task.FieldShard, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedShard(); ok {
+		_spec.AddField(task.FieldShard, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.MaxAttempts(); ok {
+		_spec.SetField(task.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(task.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if tuo.mutation.MaxAttemptsCleared() {
+		_spec.ClearField(task.FieldMaxAttempts, field.TypeInt)
+	}
+	if value, ok := tuo.mutation.RetryBaseDelayMs(); ok {
+		_spec.SetField(task.FieldRetryBaseDelayMs, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedRetryBaseDelayMs(); ok {
+		_spec.AddField(task.FieldRetryBaseDelayMs, field.TypeInt64, value)
+	}
+	if tuo.mutation.RetryBaseDelayMsCleared() {
+		_spec.ClearField(task.FieldRetryBaseDelayMs, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.RetryMaxDelayMs(); ok {
+		_spec.SetField(task.FieldRetryMaxDelayMs, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedRetryMaxDelayMs(); ok {
+		_spec.AddField(task.FieldRetryMaxDelayMs, field.TypeInt64, value)
+	}
+	if tuo.mutation.RetryMaxDelayMsCleared() {
+		_spec.ClearField(task.FieldRetryMaxDelayMs, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.RetryJitter(); ok {
+		_spec.SetField(task.FieldRetryJitter, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedRetryJitter(); ok {
+		_spec.AddField(task.FieldRetryJitter, field.TypeFloat64, value)
+	}
+	if tuo.mutation.RetryJitterCleared() {
+		_spec.ClearField(task.FieldRetryJitter, field.TypeFloat64)
+	}
+	if value, ok := tuo.mutation.Caller(); ok {
+		_spec.SetField(task.FieldCaller, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.IdempotencyKey(); ok {
+		_spec.SetField(task.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if tuo.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(task.FieldIdempotencyKey, field.TypeString)
+	}
+	if value, ok := tuo.mutation.RequestHash(); ok {
+		_spec.SetField(task.FieldRequestHash, field.TypeString, value)
+	}
+	if tuo.mutation.RequestHashCleared() {
+		_spec.ClearField(task.FieldRequestHash, field.TypeString)
+	}
 	if value, ok := tuo.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -5,11 +5,11 @@ package ent
 import (
 	"fmt"
 	"strings"
-	"github.com/Av1shay/timers-scheduler-demo/ent/task"
-	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 )
 
 // TaskHistory is the model entity for the TaskHistory schema.
@@ -17,8 +17,18 @@ type TaskHistory struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// Event holds the value of the "event" field.
+	Event taskhistory.Event `json:"event,omitempty"`
 	// Error holds the value of the "error" field.
 	Error *string `json:"error,omitempty"`
+	// RunAt holds the value of the "runAt" field.
+	RunAt time.Time `json:"runAt,omitempty"`
+	// Attempt holds the value of the "attempt" field.
+	Attempt int `json:"attempt,omitempty"`
+	// HttpStatus holds the value of the "httpStatus" field.
+	HttpStatus *int `json:"httpStatus,omitempty"`
+	// LatencyMs holds the value of the "latencyMs" field.
+	LatencyMs int64 `json:"latencyMs,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -56,11 +66,11 @@ func (*TaskHistory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case taskhistory.FieldID:
+		case taskhistory.FieldID, taskhistory.FieldAttempt, taskhistory.FieldHttpStatus, taskhistory.FieldLatencyMs:
 			values[i] = new(sql.NullInt64)
-		case taskhistory.FieldError:
+		case taskhistory.FieldEvent, taskhistory.FieldError:
 			values[i] = new(sql.NullString)
-		case taskhistory.FieldCreatedAt, taskhistory.FieldUpdatedAt:
+		case taskhistory.FieldRunAt, taskhistory.FieldCreatedAt, taskhistory.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case taskhistory.ForeignKeys[0]: // task_histories
 			values[i] = new(sql.NullInt64)
@@ -85,6 +95,12 @@ func (th *TaskHistory) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			th.ID = int(value.Int64)
+		case taskhistory.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				th.Event = taskhistory.Event(value.String)
+			}
 		case taskhistory.FieldError:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field error", values[i])
@@ -92,6 +108,31 @@ func (th *TaskHistory) assignValues(columns []string, values []any) error {
 				th.Error = new(string)
 				*th.Error = value.String
 			}
+		case taskhistory.FieldRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field runAt", values[i])
+			} else if value.Valid {
+				th.RunAt = value.Time
+			}
+		case taskhistory.FieldAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt", values[i])
+			} else if value.Valid {
+				th.Attempt = int(value.Int64)
+			}
+		case taskhistory.FieldHttpStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field httpStatus", values[i])
+			} else if value.Valid {
+				th.HttpStatus = new(int)
+				*th.HttpStatus = int(value.Int64)
+			}
+		case taskhistory.FieldLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latencyMs", values[i])
+			} else if value.Valid {
+				th.LatencyMs = value.Int64
+			}
 		case taskhistory.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -144,11 +185,28 @@ func (th *TaskHistory) String() string {
 	var builder strings.Builder
 	builder.WriteString("TaskHistory(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", th.ID))
+	builder.WriteString("event=")
+	builder.WriteString(fmt.Sprintf("%v", th.Event))
+	builder.WriteString(", ")
 	if v := th.Error; v != nil {
 		builder.WriteString("error=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("runAt=")
+	builder.WriteString(th.RunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("attempt=")
+	builder.WriteString(fmt.Sprintf("%v", th.Attempt))
+	builder.WriteString(", ")
+	if v := th.HttpStatus; v != nil {
+		builder.WriteString("httpStatus=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("latencyMs=")
+	builder.WriteString(fmt.Sprintf("%v", th.LatencyMs))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(th.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
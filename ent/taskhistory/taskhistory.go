@@ -3,6 +3,7 @@
 package taskhistory
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -11,8 +12,18 @@ const (
 	Label = "task_history"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldEvent holds the string denoting the event field in the database.
+	FieldEvent = "event"
 	// FieldError holds the string denoting the error field in the database.
 	FieldError = "error"
+	// FieldRunAt holds the string denoting the runat field in the database.
+	FieldRunAt = "run_at"
+	// FieldAttempt holds the string denoting the attempt field in the database.
+	FieldAttempt = "attempt"
+	// FieldHttpStatus holds the string denoting the httpstatus field in the database.
+	FieldHttpStatus = "http_status"
+	// FieldLatencyMs holds the string denoting the latencyms field in the database.
+	FieldLatencyMs = "latency_ms"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -33,7 +44,12 @@ const (
 // Columns holds all SQL columns for taskhistory fields.
 var Columns = []string{
 	FieldID,
+	FieldEvent,
 	FieldError,
+	FieldRunAt,
+	FieldAttempt,
+	FieldHttpStatus,
+	FieldLatencyMs,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -60,6 +76,12 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultRunAt holds the default value on creation for the "runAt" field.
+	DefaultRunAt func() time.Time
+	// DefaultAttempt holds the default value on creation for the "attempt" field.
+	DefaultAttempt int
+	// DefaultLatencyMs holds the default value on creation for the "latencyMs" field.
+	DefaultLatencyMs int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -67,3 +89,29 @@ var (
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
 )
+
+// Event defines the type for the "event" enum field.
+type Event string
+
+// EventRun is the default value of the Event enum.
+const DefaultEvent = EventRun
+
+// Event values.
+const (
+	EventRun     Event = "run"
+	EventReclaim Event = "reclaim"
+)
+
+func (e Event) String() string {
+	return string(e)
+}
+
+// EventValidator is a validator for the "event" field enum values. It is called by the builders before save.
+func EventValidator(e Event) error {
+	switch e {
+	case EventRun, EventReclaim:
+		return nil
+	default:
+		return fmt.Errorf("taskhistory: invalid enum value for event field: %q", e)
+	}
+}
@@ -3,11 +3,11 @@
 package taskhistory
 
 import (
-	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
 )
 
 // ID filters vertices based on their ID field.
@@ -88,6 +88,34 @@ func Error(v string) predicate.TaskHistory {
 	})
 }
 
+// RunAt applies equality check predicate on the "runAt" field. It's identical to RunAtEQ.
+func RunAt(v time.Time) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRunAt), v))
+	})
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempt), v))
+	})
+}
+
+// HttpStatus applies equality check predicate on the "httpStatus" field. It's identical to HttpStatusEQ.
+func HttpStatus(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHttpStatus), v))
+	})
+}
+
+// LatencyMs applies equality check predicate on the "latencyMs" field. It's identical to LatencyMsEQ.
+func LatencyMs(v int64) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLatencyMs), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.TaskHistory {
 	return predicate.TaskHistory(func(s *sql.Selector) {
@@ -102,6 +130,42 @@ func UpdatedAt(v time.Time) predicate.TaskHistory {
 	})
 }
 
+// EventEQ applies the EQ predicate on the "event" field.
+func EventEQ(v Event) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEvent), v))
+	})
+}
+
+// EventNEQ applies the NEQ predicate on the "event" field.
+func EventNEQ(v Event) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEvent), v))
+	})
+}
+
+// EventIn applies the In predicate on the "event" field.
+func EventIn(vs ...Event) predicate.TaskHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldEvent), v...))
+	})
+}
+
+// EventNotIn applies the NotIn predicate on the "event" field.
+func EventNotIn(vs ...Event) predicate.TaskHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldEvent), v...))
+	})
+}
+
 // ErrorEQ applies the EQ predicate on the "error" field.
 func ErrorEQ(v string) predicate.TaskHistory {
 	return predicate.TaskHistory(func(s *sql.Selector) {
@@ -215,6 +279,276 @@ func ErrorContainsFold(v string) predicate.TaskHistory {
 	})
 }
 
+// RunAtEQ applies the EQ predicate on the "runAt" field.
+func RunAtEQ(v time.Time) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRunAt), v))
+	})
+}
+
+// RunAtNEQ applies the NEQ predicate on the "runAt" field.
+func RunAtNEQ(v time.Time) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRunAt), v))
+	})
+}
+
+// RunAtIn applies the In predicate on the "runAt" field.
+func RunAtIn(vs ...time.Time) predicate.TaskHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldRunAt), v...))
+	})
+}
+
+// RunAtNotIn applies the NotIn predicate on the "runAt" field.
+func RunAtNotIn(vs ...time.Time) predicate.TaskHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldRunAt), v...))
+	})
+}
+
+// RunAtGT applies the GT predicate on the "runAt" field.
+func RunAtGT(v time.Time) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRunAt), v))
+	})
+}
+
+// RunAtGTE applies the GTE predicate on the "runAt" field.
+func RunAtGTE(v time.Time) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRunAt), v))
+	})
+}
+
+// RunAtLT applies the LT predicate on the "runAt" field.
+func RunAtLT(v time.Time) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRunAt), v))
+	})
+}
+
+// RunAtLTE applies the LTE predicate on the "runAt" field.
+func RunAtLTE(v time.Time) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRunAt), v))
+	})
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempt), v))
+	})
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAttempt), v))
+	})
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...int) predicate.TaskHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAttempt), v...))
+	})
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...int) predicate.TaskHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAttempt), v...))
+	})
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAttempt), v))
+	})
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAttempt), v))
+	})
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAttempt), v))
+	})
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAttempt), v))
+	})
+}
+
+// HttpStatusEQ applies the EQ predicate on the "httpStatus" field.
+func HttpStatusEQ(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHttpStatus), v))
+	})
+}
+
+// HttpStatusNEQ applies the NEQ predicate on the "httpStatus" field.
+func HttpStatusNEQ(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHttpStatus), v))
+	})
+}
+
+// HttpStatusIn applies the In predicate on the "httpStatus" field.
+func HttpStatusIn(vs ...int) predicate.TaskHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldHttpStatus), v...))
+	})
+}
+
+// HttpStatusNotIn applies the NotIn predicate on the "httpStatus" field.
+func HttpStatusNotIn(vs ...int) predicate.TaskHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldHttpStatus), v...))
+	})
+}
+
+// HttpStatusGT applies the GT predicate on the "httpStatus" field.
+func HttpStatusGT(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHttpStatus), v))
+	})
+}
+
+// HttpStatusGTE applies the GTE predicate on the "httpStatus" field.
+func HttpStatusGTE(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHttpStatus), v))
+	})
+}
+
+// HttpStatusLT applies the LT predicate on the "httpStatus" field.
+func HttpStatusLT(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHttpStatus), v))
+	})
+}
+
+// HttpStatusLTE applies the LTE predicate on the "httpStatus" field.
+func HttpStatusLTE(v int) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHttpStatus), v))
+	})
+}
+
+// HttpStatusIsNil applies the IsNil predicate on the "httpStatus" field.
+func HttpStatusIsNil() predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldHttpStatus)))
+	})
+}
+
+// HttpStatusNotNil applies the NotNil predicate on the "httpStatus" field.
+func HttpStatusNotNil() predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldHttpStatus)))
+	})
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latencyMs" field.
+func LatencyMsEQ(v int64) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLatencyMs), v))
+	})
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latencyMs" field.
+func LatencyMsNEQ(v int64) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLatencyMs), v))
+	})
+}
+
+// LatencyMsIn applies the In predicate on the "latencyMs" field.
+func LatencyMsIn(vs ...int64) predicate.TaskHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLatencyMs), v...))
+	})
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latencyMs" field.
+func LatencyMsNotIn(vs ...int64) predicate.TaskHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLatencyMs), v...))
+	})
+}
+
+// LatencyMsGT applies the GT predicate on the "latencyMs" field.
+func LatencyMsGT(v int64) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLatencyMs), v))
+	})
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latencyMs" field.
+func LatencyMsGTE(v int64) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLatencyMs), v))
+	})
+}
+
+// LatencyMsLT applies the LT predicate on the "latencyMs" field.
+func LatencyMsLT(v int64) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLatencyMs), v))
+	})
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latencyMs" field.
+func LatencyMsLTE(v int64) predicate.TaskHistory {
+	return predicate.TaskHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLatencyMs), v))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.TaskHistory {
 	return predicate.TaskHistory(func(s *sql.Selector) {
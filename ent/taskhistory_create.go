@@ -6,12 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/Av1shay/timers-scheduler-demo/ent/task"
-	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 )
 
 // TaskHistoryCreate is the builder for creating a TaskHistory entity.
@@ -21,6 +21,20 @@ type TaskHistoryCreate struct {
 	hooks    []Hook
 }
 
+// SetEvent sets the "event" field.
+func (thc *TaskHistoryCreate) SetEvent(t taskhistory.Event) *TaskHistoryCreate {
+	thc.mutation.SetEvent(t)
+	return thc
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (thc *TaskHistoryCreate) SetNillableEvent(t *taskhistory.Event) *TaskHistoryCreate {
+	if t != nil {
+		thc.SetEvent(*t)
+	}
+	return thc
+}
+
 // SetError sets the "error" field.
 func (thc *TaskHistoryCreate) SetError(s string) *TaskHistoryCreate {
 	thc.mutation.SetError(s)
@@ -35,6 +49,62 @@ func (thc *TaskHistoryCreate) SetNillableError(s *string) *TaskHistoryCreate {
 	return thc
 }
 
+// SetRunAt sets the "runAt" field.
+func (thc *TaskHistoryCreate) SetRunAt(t time.Time) *TaskHistoryCreate {
+	thc.mutation.SetRunAt(t)
+	return thc
+}
+
+// SetNillableRunAt sets the "runAt" field if the given value is not nil.
+func (thc *TaskHistoryCreate) SetNillableRunAt(t *time.Time) *TaskHistoryCreate {
+	if t != nil {
+		thc.SetRunAt(*t)
+	}
+	return thc
+}
+
+// SetAttempt sets the "attempt" field.
+func (thc *TaskHistoryCreate) SetAttempt(i int) *TaskHistoryCreate {
+	thc.mutation.SetAttempt(i)
+	return thc
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (thc *TaskHistoryCreate) SetNillableAttempt(i *int) *TaskHistoryCreate {
+	if i != nil {
+		thc.SetAttempt(*i)
+	}
+	return thc
+}
+
+// SetHttpStatus sets the "httpStatus" field.
+func (thc *TaskHistoryCreate) SetHttpStatus(i int) *TaskHistoryCreate {
+	thc.mutation.SetHttpStatus(i)
+	return thc
+}
+
+// SetNillableHttpStatus sets the "httpStatus" field if the given value is not nil.
+func (thc *TaskHistoryCreate) SetNillableHttpStatus(i *int) *TaskHistoryCreate {
+	if i != nil {
+		thc.SetHttpStatus(*i)
+	}
+	return thc
+}
+
+// SetLatencyMs sets the "latencyMs" field.
+func (thc *TaskHistoryCreate) SetLatencyMs(i int64) *TaskHistoryCreate {
+	thc.mutation.SetLatencyMs(i)
+	return thc
+}
+
+// SetNillableLatencyMs sets the "latencyMs" field if the given value is not nil.
+func (thc *TaskHistoryCreate) SetNillableLatencyMs(i *int64) *TaskHistoryCreate {
+	if i != nil {
+		thc.SetLatencyMs(*i)
+	}
+	return thc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (thc *TaskHistoryCreate) SetCreatedAt(t time.Time) *TaskHistoryCreate {
 	thc.mutation.SetCreatedAt(t)
@@ -159,6 +229,22 @@ func (thc *TaskHistoryCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (thc *TaskHistoryCreate) defaults() {
+	if _, ok := thc.mutation.Event(); !ok {
+		v := taskhistory.DefaultEvent
+		thc.mutation.SetEvent(v)
+	}
+	if _, ok := thc.mutation.RunAt(); !ok {
+		v := taskhistory.DefaultRunAt()
+		thc.mutation.SetRunAt(v)
+	}
+	if _, ok := thc.mutation.Attempt(); !ok {
+		v := taskhistory.DefaultAttempt
+		thc.mutation.SetAttempt(v)
+	}
+	if _, ok := thc.mutation.LatencyMs(); !ok {
+		v := taskhistory.DefaultLatencyMs
+		thc.mutation.SetLatencyMs(v)
+	}
 	if _, ok := thc.mutation.CreatedAt(); !ok {
 		v := taskhistory.DefaultCreatedAt()
 		thc.mutation.SetCreatedAt(v)
@@ -171,6 +257,23 @@ func (thc *TaskHistoryCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (thc *TaskHistoryCreate) check() error {
+	if _, ok := thc.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "TaskHistory.event"`)}
+	}
+	if v, ok := thc.mutation.Event(); ok {
+		if err := taskhistory.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "TaskHistory.event": %w`, err)}
+		}
+	}
+	if _, ok := thc.mutation.RunAt(); !ok {
+		return &ValidationError{Name: "runAt", err: errors.New(`ent: missing required field "TaskHistory.runAt"`)}
+	}
+	if _, ok := thc.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required field "TaskHistory.attempt"`)}
+	}
+	if _, ok := thc.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latencyMs", err: errors.New(`ent: missing required field "TaskHistory.latencyMs"`)}
+	}
 	if _, ok := thc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskHistory.created_at"`)}
 	}
@@ -204,10 +307,30 @@ func (thc *TaskHistoryCreate) createSpec() (*TaskHistory, *sqlgraph.CreateSpec)
 			},
 		}
 	)
+	if value, ok := thc.mutation.Event(); ok {
+		_spec.SetField(taskhistory.FieldEvent, field.TypeEnum, value)
+		_node.Event = value
+	}
 	if value, ok := thc.mutation.Error(); ok {
 		_spec.SetField(taskhistory.FieldError, field.TypeString, value)
 		_node.Error = &value
 	}
+	if value, ok := thc.mutation.RunAt(); ok {
+		_spec.SetField(taskhistory.FieldRunAt, field.TypeTime, value)
+		_node.RunAt = value
+	}
+	if value, ok := thc.mutation.Attempt(); ok {
+		_spec.SetField(taskhistory.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := thc.mutation.HttpStatus(); ok {
+		_spec.SetField(taskhistory.FieldHttpStatus, field.TypeInt, value)
+		_node.HttpStatus = &value
+	}
+	if value, ok := thc.mutation.LatencyMs(); ok {
+		_spec.SetField(taskhistory.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
 	if value, ok := thc.mutation.CreatedAt(); ok {
 		_spec.SetField(taskhistory.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
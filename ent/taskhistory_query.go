@@ -6,13 +6,14 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
-	"github.com/Av1shay/timers-scheduler-demo/ent/task"
-	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 )
 
 // TaskHistoryQuery is the builder for querying TaskHistory entities.
@@ -26,6 +27,7 @@ type TaskHistoryQuery struct {
 	predicates []predicate.TaskHistory
 	withTask   *TaskQuery
 	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -290,15 +292,14 @@ func (thq *TaskHistoryQuery) WithTask(opts ...func(*TaskQuery)) *TaskHistoryQuer
 // Example:
 //
 //	var v []struct {
-//		Error string `json:"error,omitempty"`
+//		Event taskhistory.Event `json:"event,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.TaskHistory.Query().
-//		GroupBy(taskhistory.FieldError).
+//		GroupBy(taskhistory.FieldEvent).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (thq *TaskHistoryQuery) GroupBy(field string, fields ...string) *TaskHistoryGroupBy {
 	grbuild := &TaskHistoryGroupBy{config: thq.config}
 	grbuild.fields = append([]string{field}, fields...)
@@ -319,13 +320,12 @@ func (thq *TaskHistoryQuery) GroupBy(field string, fields ...string) *TaskHistor
 // Example:
 //
 //	var v []struct {
-//		Error string `json:"error,omitempty"`
+//		Event taskhistory.Event `json:"event,omitempty"`
 //	}
 //
 //	client.TaskHistory.Query().
-//		Select(taskhistory.FieldError).
+//		Select(taskhistory.FieldEvent).
 //		Scan(ctx, &v)
-//
 func (thq *TaskHistoryQuery) Select(fields ...string) *TaskHistorySelect {
 	thq.fields = append(thq.fields, fields...)
 	selbuild := &TaskHistorySelect{TaskHistoryQuery: thq}
@@ -379,6 +379,9 @@ func (thq *TaskHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(thq.modifiers) > 0 {
+		_spec.Modifiers = thq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -429,6 +432,9 @@ func (thq *TaskHistoryQuery) loadTask(ctx context.Context, query *TaskQuery, nod
 
 func (thq *TaskHistoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := thq.querySpec()
+	if len(thq.modifiers) > 0 {
+		_spec.Modifiers = thq.modifiers
+	}
 	_spec.Node.Columns = thq.fields
 	if len(thq.fields) > 0 {
 		_spec.Unique = thq.unique != nil && *thq.unique
@@ -510,6 +516,9 @@ func (thq *TaskHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if thq.unique != nil && *thq.unique {
 		selector.Distinct()
 	}
+	for _, m := range thq.modifiers {
+		m(selector)
+	}
 	for _, p := range thq.predicates {
 		p(selector)
 	}
@@ -527,6 +536,32 @@ func (thq *TaskHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (thq *TaskHistoryQuery) ForUpdate(opts ...sql.LockOption) *TaskHistoryQuery {
+	if thq.driver.Dialect() == dialect.Postgres {
+		thq.Unique(false)
+	}
+	thq.modifiers = append(thq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return thq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (thq *TaskHistoryQuery) ForShare(opts ...sql.LockOption) *TaskHistoryQuery {
+	if thq.driver.Dialect() == dialect.Postgres {
+		thq.Unique(false)
+	}
+	thq.modifiers = append(thq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return thq
+}
+
 // TaskHistoryGroupBy is the group-by builder for TaskHistory entities.
 type TaskHistoryGroupBy struct {
 	config
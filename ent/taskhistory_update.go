@@ -6,14 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
-	"github.com/Av1shay/timers-scheduler-demo/ent/task"
-	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 )
 
 // TaskHistoryUpdate is the builder for updating TaskHistory entities.
@@ -29,6 +29,20 @@ func (thu *TaskHistoryUpdate) Where(ps ...predicate.TaskHistory) *TaskHistoryUpd
 	return thu
 }
 
+// SetEvent sets the "event" field.
+func (thu *TaskHistoryUpdate) SetEvent(t taskhistory.Event) *TaskHistoryUpdate {
+	thu.mutation.SetEvent(t)
+	return thu
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (thu *TaskHistoryUpdate) SetNillableEvent(t *taskhistory.Event) *TaskHistoryUpdate {
+	if t != nil {
+		thu.SetEvent(*t)
+	}
+	return thu
+}
+
 // SetError sets the "error" field.
 func (thu *TaskHistoryUpdate) SetError(s string) *TaskHistoryUpdate {
 	thu.mutation.SetError(s)
@@ -49,6 +63,89 @@ func (thu *TaskHistoryUpdate) ClearError() *TaskHistoryUpdate {
 	return thu
 }
 
+// SetRunAt sets the "runAt" field.
+func (thu *TaskHistoryUpdate) SetRunAt(t time.Time) *TaskHistoryUpdate {
+	thu.mutation.SetRunAt(t)
+	return thu
+}
+
+// SetNillableRunAt sets the "runAt" field if the given value is not nil.
+func (thu *TaskHistoryUpdate) SetNillableRunAt(t *time.Time) *TaskHistoryUpdate {
+	if t != nil {
+		thu.SetRunAt(*t)
+	}
+	return thu
+}
+
+// SetAttempt sets the "attempt" field.
+func (thu *TaskHistoryUpdate) SetAttempt(i int) *TaskHistoryUpdate {
+	thu.mutation.ResetAttempt()
+	thu.mutation.SetAttempt(i)
+	return thu
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (thu *TaskHistoryUpdate) SetNillableAttempt(i *int) *TaskHistoryUpdate {
+	if i != nil {
+		thu.SetAttempt(*i)
+	}
+	return thu
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (thu *TaskHistoryUpdate) AddAttempt(i int) *TaskHistoryUpdate {
+	thu.mutation.AddAttempt(i)
+	return thu
+}
+
+// SetHttpStatus sets the "httpStatus" field.
+func (thu *TaskHistoryUpdate) SetHttpStatus(i int) *TaskHistoryUpdate {
+	thu.mutation.ResetHttpStatus()
+	thu.mutation.SetHttpStatus(i)
+	return thu
+}
+
+// SetNillableHttpStatus sets the "httpStatus" field if the given value is not nil.
+func (thu *TaskHistoryUpdate) SetNillableHttpStatus(i *int) *TaskHistoryUpdate {
+	if i != nil {
+		thu.SetHttpStatus(*i)
+	}
+	return thu
+}
+
+// AddHttpStatus adds i to the "httpStatus" field.
+func (thu *TaskHistoryUpdate) AddHttpStatus(i int) *TaskHistoryUpdate {
+	thu.mutation.AddHttpStatus(i)
+	return thu
+}
+
+// ClearHttpStatus clears the value of the "httpStatus" field.
+func (thu *TaskHistoryUpdate) ClearHttpStatus() *TaskHistoryUpdate {
+	thu.mutation.ClearHttpStatus()
+	return thu
+}
+
+// SetLatencyMs sets the "latencyMs" field.
+func (thu *TaskHistoryUpdate) SetLatencyMs(i int64) *TaskHistoryUpdate {
+	thu.mutation.ResetLatencyMs()
+	thu.mutation.SetLatencyMs(i)
+	return thu
+}
+
+// SetNillableLatencyMs sets the "latencyMs" field if the given value is not nil.
+func (thu *TaskHistoryUpdate) SetNillableLatencyMs(i *int64) *TaskHistoryUpdate {
+	if i != nil {
+		thu.SetLatencyMs(*i)
+	}
+	return thu
+}
+
+// AddLatencyMs adds i to the "latencyMs" field.
+func (thu *TaskHistoryUpdate) AddLatencyMs(i int64) *TaskHistoryUpdate {
+	thu.mutation.AddLatencyMs(i)
+	return thu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (thu *TaskHistoryUpdate) SetCreatedAt(t time.Time) *TaskHistoryUpdate {
 	thu.mutation.SetCreatedAt(t)
@@ -107,6 +204,9 @@ func (thu *TaskHistoryUpdate) Save(ctx context.Context) (int, error) {
 	)
 	thu.defaults()
 	if len(thu.hooks) == 0 {
+		if err = thu.check(); err != nil {
+			return 0, err
+		}
 		affected, err = thu.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -114,6 +214,9 @@ func (thu *TaskHistoryUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = thu.check(); err != nil {
+				return 0, err
+			}
 			thu.mutation = mutation
 			affected, err = thu.sqlSave(ctx)
 			mutation.done = true
@@ -162,6 +265,16 @@ func (thu *TaskHistoryUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (thu *TaskHistoryUpdate) check() error {
+	if v, ok := thu.mutation.Event(); ok {
+		if err := taskhistory.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "TaskHistory.event": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (thu *TaskHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -180,12 +293,39 @@ func (thu *TaskHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := thu.mutation.Event(); ok {
+		_spec.SetField(taskhistory.FieldEvent, field.TypeEnum, value)
+	}
 	if value, ok := thu.mutation.Error(); ok {
 		_spec.SetField(taskhistory.FieldError, field.TypeString, value)
 	}
 	if thu.mutation.ErrorCleared() {
 		_spec.ClearField(taskhistory.FieldError, field.TypeString)
 	}
+	if value, ok := thu.mutation.RunAt(); ok {
+		_spec.SetField(taskhistory.FieldRunAt, field.TypeTime, value)
+	}
+	if value, ok := thu.mutation.Attempt(); ok {
+		_spec.SetField(taskhistory.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := thu.mutation.AddedAttempt(); ok {
+		_spec.AddField(taskhistory.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := thu.mutation.HttpStatus(); ok {
+		_spec.SetField(taskhistory.FieldHttpStatus, field.TypeInt, value)
+	}
+	if value, ok := thu.mutation.AddedHttpStatus(); ok {
+		_spec.AddField(taskhistory.FieldHttpStatus, field.TypeInt, value)
+	}
+	if thu.mutation.HttpStatusCleared() {
+		_spec.ClearField(taskhistory.FieldHttpStatus, field.TypeInt)
+	}
+	if value, ok := thu.mutation.LatencyMs(); ok {
+		_spec.SetField(taskhistory.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := thu.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(taskhistory.FieldLatencyMs, field.TypeInt64, value)
+	}
 	if value, ok := thu.mutation.CreatedAt(); ok {
 		_spec.SetField(taskhistory.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -246,6 +386,20 @@ type TaskHistoryUpdateOne struct {
 	mutation *TaskHistoryMutation
 }
 
+// SetEvent sets the "event" field.
+func (thuo *TaskHistoryUpdateOne) SetEvent(t taskhistory.Event) *TaskHistoryUpdateOne {
+	thuo.mutation.SetEvent(t)
+	return thuo
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (thuo *TaskHistoryUpdateOne) SetNillableEvent(t *taskhistory.Event) *TaskHistoryUpdateOne {
+	if t != nil {
+		thuo.SetEvent(*t)
+	}
+	return thuo
+}
+
 // SetError sets the "error" field.
 func (thuo *TaskHistoryUpdateOne) SetError(s string) *TaskHistoryUpdateOne {
 	thuo.mutation.SetError(s)
@@ -266,6 +420,89 @@ func (thuo *TaskHistoryUpdateOne) ClearError() *TaskHistoryUpdateOne {
 	return thuo
 }
 
+// SetRunAt sets the "runAt" field.
+func (thuo *TaskHistoryUpdateOne) SetRunAt(t time.Time) *TaskHistoryUpdateOne {
+	thuo.mutation.SetRunAt(t)
+	return thuo
+}
+
+// SetNillableRunAt sets the "runAt" field if the given value is not nil.
+func (thuo *TaskHistoryUpdateOne) SetNillableRunAt(t *time.Time) *TaskHistoryUpdateOne {
+	if t != nil {
+		thuo.SetRunAt(*t)
+	}
+	return thuo
+}
+
+// SetAttempt sets the "attempt" field.
+func (thuo *TaskHistoryUpdateOne) SetAttempt(i int) *TaskHistoryUpdateOne {
+	thuo.mutation.ResetAttempt()
+	thuo.mutation.SetAttempt(i)
+	return thuo
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (thuo *TaskHistoryUpdateOne) SetNillableAttempt(i *int) *TaskHistoryUpdateOne {
+	if i != nil {
+		thuo.SetAttempt(*i)
+	}
+	return thuo
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (thuo *TaskHistoryUpdateOne) AddAttempt(i int) *TaskHistoryUpdateOne {
+	thuo.mutation.AddAttempt(i)
+	return thuo
+}
+
+// SetHttpStatus sets the "httpStatus" field.
+func (thuo *TaskHistoryUpdateOne) SetHttpStatus(i int) *TaskHistoryUpdateOne {
+	thuo.mutation.ResetHttpStatus()
+	thuo.mutation.SetHttpStatus(i)
+	return thuo
+}
+
+// SetNillableHttpStatus sets the "httpStatus" field if the given value is not nil.
+func (thuo *TaskHistoryUpdateOne) SetNillableHttpStatus(i *int) *TaskHistoryUpdateOne {
+	if i != nil {
+		thuo.SetHttpStatus(*i)
+	}
+	return thuo
+}
+
+// AddHttpStatus adds i to the "httpStatus" field.
+func (thuo *TaskHistoryUpdateOne) AddHttpStatus(i int) *TaskHistoryUpdateOne {
+	thuo.mutation.AddHttpStatus(i)
+	return thuo
+}
+
+// ClearHttpStatus clears the value of the "httpStatus" field.
+func (thuo *TaskHistoryUpdateOne) ClearHttpStatus() *TaskHistoryUpdateOne {
+	thuo.mutation.ClearHttpStatus()
+	return thuo
+}
+
+// SetLatencyMs sets the "latencyMs" field.
+func (thuo *TaskHistoryUpdateOne) SetLatencyMs(i int64) *TaskHistoryUpdateOne {
+	thuo.mutation.ResetLatencyMs()
+	thuo.mutation.SetLatencyMs(i)
+	return thuo
+}
+
+// SetNillableLatencyMs sets the "latencyMs" field if the given value is not nil.
+func (thuo *TaskHistoryUpdateOne) SetNillableLatencyMs(i *int64) *TaskHistoryUpdateOne {
+	if i != nil {
+		thuo.SetLatencyMs(*i)
+	}
+	return thuo
+}
+
+// AddLatencyMs adds i to the "latencyMs" field.
+func (thuo *TaskHistoryUpdateOne) AddLatencyMs(i int64) *TaskHistoryUpdateOne {
+	thuo.mutation.AddLatencyMs(i)
+	return thuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (thuo *TaskHistoryUpdateOne) SetCreatedAt(t time.Time) *TaskHistoryUpdateOne {
 	thuo.mutation.SetCreatedAt(t)
@@ -331,6 +568,9 @@ func (thuo *TaskHistoryUpdateOne) Save(ctx context.Context) (*TaskHistory, error
 	)
 	thuo.defaults()
 	if len(thuo.hooks) == 0 {
+		if err = thuo.check(); err != nil {
+			return nil, err
+		}
 		node, err = thuo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -338,6 +578,9 @@ func (thuo *TaskHistoryUpdateOne) Save(ctx context.Context) (*TaskHistory, error
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = thuo.check(); err != nil {
+				return nil, err
+			}
 			thuo.mutation = mutation
 			node, err = thuo.sqlSave(ctx)
 			mutation.done = true
@@ -392,6 +635,16 @@ func (thuo *TaskHistoryUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (thuo *TaskHistoryUpdateOne) check() error {
+	if v, ok := thuo.mutation.Event(); ok {
+		if err := taskhistory.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "TaskHistory.event": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (thuo *TaskHistoryUpdateOne) sqlSave(ctx context.Context) (_node *TaskHistory, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -427,12 +680,39 @@ func (thuo *TaskHistoryUpdateOne) sqlSave(ctx context.Context) (_node *TaskHisto
 			}
 		}
 	}
+	if value, ok := thuo.mutation.Event(); ok {
+		_spec.SetField(taskhistory.FieldEvent, field.TypeEnum, value)
+	}
 	if value, ok := thuo.mutation.Error(); ok {
 		_spec.SetField(taskhistory.FieldError, field.TypeString, value)
 	}
 	if thuo.mutation.ErrorCleared() {
 		_spec.ClearField(taskhistory.FieldError, field.TypeString)
 	}
+	if value, ok := thuo.mutation.RunAt(); ok {
+		_spec.SetField(taskhistory.FieldRunAt, field.TypeTime, value)
+	}
+	if value, ok := thuo.mutation.Attempt(); ok {
+		_spec.SetField(taskhistory.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := thuo.mutation.AddedAttempt(); ok {
+		_spec.AddField(taskhistory.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := thuo.mutation.HttpStatus(); ok {
+		_spec.SetField(taskhistory.FieldHttpStatus, field.TypeInt, value)
+	}
+	if value, ok := thuo.mutation.AddedHttpStatus(); ok {
+		_spec.AddField(taskhistory.FieldHttpStatus, field.TypeInt, value)
+	}
+	if thuo.mutation.HttpStatusCleared() {
+		_spec.ClearField(taskhistory.FieldHttpStatus, field.TypeInt)
+	}
+	if value, ok := thuo.mutation.LatencyMs(); ok {
+		_spec.SetField(taskhistory.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := thuo.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(taskhistory.FieldLatencyMs, field.TypeInt64, value)
+	}
 	if value, ok := thuo.mutation.CreatedAt(); ok {
 		_spec.SetField(taskhistory.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -12,6 +12,12 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// OutboxMessage is the client for interacting with the OutboxMessage builders.
+	OutboxMessage *OutboxMessageClient
+	// QueueJob is the client for interacting with the QueueJob builders.
+	QueueJob *QueueJobClient
+	// SchedulerInstance is the client for interacting with the SchedulerInstance builders.
+	SchedulerInstance *SchedulerInstanceClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// TaskHistory is the client for interacting with the TaskHistory builders.
@@ -147,6 +153,9 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.OutboxMessage = NewOutboxMessageClient(tx.config)
+	tx.QueueJob = NewQueueJobClient(tx.config)
+	tx.SchedulerInstance = NewSchedulerInstanceClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
 	tx.TaskHistory = NewTaskHistoryClient(tx.config)
 }
@@ -158,7 +167,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Task.QueryXXX(), the query will be executed
+// applies a query, for example: OutboxMessage.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
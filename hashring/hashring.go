@@ -0,0 +1,86 @@
+// Package hashring implements a consistent hash ring. Every member is placed on the ring in several virtual nodes,
+// and a key is owned by the first member clockwise from the key's hash, so when a member joins or leaves only
+// the keys of its neighbours move.
+package hashring
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+)
+
+// DefaultReplicas is the number of virtual nodes of a member, more nodes spread the keys more evenly
+const DefaultReplicas = 100
+
+type Ring struct {
+	replicas int
+	hashes   []uint64 // sorted
+	owners   map[uint64]string
+	members  []string
+}
+
+// New creates a ring of the given members, the order of the members doesn't matter
+func New(replicas int, members ...string) *Ring {
+	r := &Ring{
+		replicas: replicas,
+		owners:   make(map[uint64]string, len(members)*replicas),
+	}
+	for _, member := range members {
+		r.add(member)
+	}
+	sort.Slice(r.hashes, func(i, j int) bool { return r.hashes[i] < r.hashes[j] })
+	sort.Strings(r.members)
+	return r
+}
+
+func (r *Ring) add(member string) {
+	if _, ok := r.index(member); ok {
+		return
+	}
+	r.members = append(r.members, member)
+	for i := 0; i < r.replicas; i++ {
+		h := hash(member + "#" + strconv.Itoa(i))
+		// on a collision the smaller member wins, so the ring doesn't depend on the order of the members
+		if owner, ok := r.owners[h]; ok {
+			if member < owner {
+				r.owners[h] = member
+			}
+			continue
+		}
+		r.owners[h] = member
+		r.hashes = append(r.hashes, h)
+	}
+}
+
+func (r *Ring) index(member string) (int, bool) {
+	for i, m := range r.members {
+		if m == member {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Owner returns the member that owns the key, or empty string when the ring has no members
+func (r *Ring) Owner(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+	h := hash(key)
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.owners[r.hashes[i]]
+}
+
+// Members returns the sorted members of the ring
+func (r *Ring) Members() []string {
+	return append([]string(nil), r.members...)
+}
+
+func hash(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,60 @@
+package hashring
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestRing_Owner(t *testing.T) {
+	if owner := New(DefaultReplicas).Owner("key"); owner != "" {
+		t.Errorf("expected empty ring to have no owner, got %s", owner)
+	}
+
+	r1 := New(DefaultReplicas, "a", "b", "c")
+	r2 := New(DefaultReplicas, "c", "a", "b", "a")
+	counts := make(map[string]int)
+	for i := 0; i < 3000; i++ {
+		key := strconv.Itoa(i)
+		owner := r1.Owner(key)
+		if owner != r2.Owner(key) {
+			t.Fatalf("expected owner of %s not to depend on the order of the members", key)
+		}
+		counts[owner]++
+	}
+	if len(counts) != 3 {
+		t.Fatalf("expected keys to be owned by 3 members, got %v", counts)
+	}
+	for member, count := range counts {
+		if count < 700 || count > 1300 {
+			t.Errorf("expected member %s to own about a third of the keys, got %d", member, count)
+		}
+	}
+}
+
+func TestRing_Rebalance(t *testing.T) {
+	before := New(DefaultReplicas, "a", "b", "c")
+	after := New(DefaultReplicas, "a", "b", "c", "d")
+
+	moved := 0
+	for i := 0; i < 3000; i++ {
+		key := strconv.Itoa(i)
+		from, to := before.Owner(key), after.Owner(key)
+		if from != to {
+			moved++
+			if to != "d" {
+				t.Fatalf("expected key %s to move only to the new member, moved from %s to %s", key, from, to)
+			}
+		}
+	}
+	if moved < 450 || moved > 1050 {
+		t.Errorf("expected about a quarter of the keys to move, got %d", moved)
+	}
+
+}
+
+func TestRing_Members(t *testing.T) {
+	members := New(DefaultReplicas, "b", "a", "b").Members()
+	if len(members) != 2 || members[0] != "a" || members[1] != "b" {
+		t.Errorf("expected members [a b], got %v", members)
+	}
+}
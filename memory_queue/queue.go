@@ -0,0 +1,227 @@
+// Package memory_queue is an in memory queue backend, so the service can run as a single binary without a broker.
+// Messages are kept only in the process, the tasks of messages that are lost when it exits are fired again
+// once their lease expires
+package memory_queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Av1shay/timers-scheduler-demo/task"
+	"github.com/google/uuid"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultBufferSize is the number of messages that can wait in the queue before Publish blocks
+const DefaultBufferSize = 10000
+
+var (
+	// ErrClosed is returned when publishing to a queue that was shut down
+	ErrClosed = errors.New("queue is closed")
+	// ErrAlreadyAcknowledged is returned when a message is acked or nacked more than once
+	ErrAlreadyAcknowledged = errors.New("message was already acked or nacked")
+)
+
+type Queue struct {
+	workers  int
+	msgs     chan *message
+	closed   chan struct{}
+	shutdown sync.Once
+	// inFlight tracks the consumer workers, Shutdown waits for them to finish their messages
+	inFlight sync.WaitGroup
+
+	// deadLettersMu guards deadLetters, which are ordered by the time they were dead lettered
+	deadLettersMu sync.Mutex
+	deadLetters   []*message
+}
+
+type message struct {
+	id     string
+	body   []byte
+	q      *Queue
+	done   int32
+	deaths int
+	deadAt time.Time
+}
+
+// New creates a queue that holds up to bufferSize messages, every consumer handles workers messages concurrently
+func New(bufferSize, workers int) *Queue {
+	return &Queue{
+		workers: workers,
+		msgs:    make(chan *message, bufferSize),
+		closed:  make(chan struct{}),
+	}
+}
+
+// Publish adds the task to the queue, it blocks while the queue is full until ctx is done
+func (q *Queue) Publish(ctx context.Context, t *task.Task) error {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	return q.publish(ctx, &message{id: uuid.NewString(), body: b, q: q})
+}
+
+func (q *Queue) publish(ctx context.Context, m *message) error {
+	select {
+	case <-q.closed:
+		return ErrClosed
+	default:
+	}
+	select {
+	case q.msgs <- m:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-q.closed:
+		return ErrClosed
+	}
+}
+
+// Consume calls handler for every message from a pool of workers, so handler is called concurrently.
+// handler must ack the message or nack it without requeue to dead letter it
+func (q *Queue) Consume(handler func(m task.Message)) error {
+	select {
+	case <-q.closed:
+		return ErrClosed
+	default:
+	}
+
+	q.inFlight.Add(q.workers)
+	for i := 0; i < q.workers; i++ {
+		go func() {
+			defer q.inFlight.Done()
+			for {
+				select {
+				case <-q.closed:
+					return
+				case m := <-q.msgs:
+					handler(m)
+				}
+			}
+		}()
+	}
+	return nil
+}
+
+// Shutdown stops consuming and waits for the messages that are being handled until ctx is done.
+// Messages that are still in the queue are dropped
+func (q *Queue) Shutdown(ctx context.Context) error {
+	q.shutdown.Do(func() {
+		close(q.closed)
+	})
+
+	drained := make(chan struct{})
+	go func() {
+		q.inFlight.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ListDeadLetters returns up to limit messages from the head of the dead letters
+func (q *Queue) ListDeadLetters(_ context.Context, limit int) ([]*task.DeadLetter, error) {
+	q.deadLettersMu.Lock()
+	defer q.deadLettersMu.Unlock()
+
+	if limit > len(q.deadLetters) {
+		limit = len(q.deadLetters)
+	}
+	deadLetters := make([]*task.DeadLetter, limit)
+	for i, m := range q.deadLetters[:limit] {
+		deadLetters[i] = m.deadLetter()
+	}
+	return deadLetters, nil
+}
+
+func (q *Queue) GetDeadLetter(_ context.Context, id string) (*task.DeadLetter, error) {
+	q.deadLettersMu.Lock()
+	defer q.deadLettersMu.Unlock()
+
+	for _, m := range q.deadLetters {
+		if m.id == id {
+			return m.deadLetter(), nil
+		}
+	}
+	return nil, task.ErrDeadLetterNotFound
+}
+
+// ReplayDeadLetter publishes the dead letter back to the queue and removes it from the dead letters
+func (q *Queue) ReplayDeadLetter(ctx context.Context, id string) error {
+	q.deadLettersMu.Lock()
+	defer q.deadLettersMu.Unlock()
+
+	for i, m := range q.deadLetters {
+		if m.id != id {
+			continue
+		}
+		if err := q.publish(ctx, m.redeliver()); err != nil {
+			return err
+		}
+		q.deadLetters = append(q.deadLetters[:i], q.deadLetters[i+1:]...)
+		return nil
+	}
+	return task.ErrDeadLetterNotFound
+}
+
+// PurgeDeadLetters deletes all the dead letters, returns the number of deleted messages
+func (q *Queue) PurgeDeadLetters(_ context.Context) (int, error) {
+	q.deadLettersMu.Lock()
+	defer q.deadLettersMu.Unlock()
+
+	n := len(q.deadLetters)
+	q.deadLetters = nil
+	return n, nil
+}
+
+func (m *message) Body() []byte {
+	return m.body
+}
+
+func (m *message) Ack() error {
+	if !atomic.CompareAndSwapInt32(&m.done, 0, 1) {
+		return ErrAlreadyAcknowledged
+	}
+	return nil
+}
+
+// Nack requeues the message at the tail of the queue, or adds it to the dead letters
+func (m *message) Nack(requeue bool) error {
+	if !atomic.CompareAndSwapInt32(&m.done, 0, 1) {
+		return ErrAlreadyAcknowledged
+	}
+	if requeue {
+		// publishing blocks when the queue is full, which would block the worker that should empty it
+		go m.q.publish(context.Background(), m.redeliver())
+		return nil
+	}
+
+	m.q.deadLettersMu.Lock()
+	defer m.q.deadLettersMu.Unlock()
+	m.deaths++
+	m.deadAt = time.Now().UTC()
+	m.q.deadLetters = append(m.q.deadLetters, m)
+	return nil
+}
+
+// redeliver returns a copy of the message that can be acked again
+func (m *message) redeliver() *message {
+	return &message{id: m.id, body: m.body, q: m.q, deaths: m.deaths}
+}
+
+func (m *message) deadLetter() *task.DeadLetter {
+	return &task.DeadLetter{
+		ID:     m.id,
+		Body:   string(m.body),
+		Reason: "rejected",
+		Count:  m.deaths,
+		DeadAt: m.deadAt,
+	}
+}
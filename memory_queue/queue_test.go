@@ -0,0 +1,178 @@
+package memory_queue
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/Av1shay/timers-scheduler-demo/task"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueue_PublishConsume(t *testing.T) {
+	ctx := context.Background()
+	q := New(100, 4)
+	defer q.Shutdown(ctx)
+
+	received := make(chan int, 10)
+	err := q.Consume(func(m task.Message) {
+		var ta task.Task
+		if err := json.Unmarshal(m.Body(), &ta); err != nil {
+			t.Error(err)
+		}
+		if err := m.Ack(); err != nil {
+			t.Error(err)
+		}
+		if err := m.Ack(); err != ErrAlreadyAcknowledged {
+			t.Errorf("expected second ack to fail with %v, got %v", ErrAlreadyAcknowledged, err)
+		}
+		received <- ta.ID
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for id := 1; id <= 10; id++ {
+		if err := q.Publish(ctx, &task.Task{ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	seen := make(map[int]bool)
+	for i := 0; i < 10; i++ {
+		select {
+		case id := <-received:
+			seen[id] = true
+		case <-time.After(time.Second):
+			t.Fatalf("expected 10 messages, got %d", i)
+		}
+	}
+	if len(seen) != 10 {
+		t.Errorf("expected 10 different tasks, got %d", len(seen))
+	}
+}
+
+func TestQueue_Nack(t *testing.T) {
+	ctx := context.Background()
+	q := New(100, 1)
+	defer q.Shutdown(ctx)
+
+	deliveries := make(chan int, 10)
+	attempts := make(map[int]int)
+	err := q.Consume(func(m task.Message) {
+		var ta task.Task
+		json.Unmarshal(m.Body(), &ta)
+		attempts[ta.ID]++
+		// task 1 is requeued once, task 2 is dead lettered
+		switch {
+		case ta.ID == 1 && attempts[ta.ID] == 1:
+			m.Nack(true)
+		case ta.ID == 2:
+			m.Nack(false)
+		default:
+			m.Ack()
+		}
+		deliveries <- ta.ID
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for id := 1; id <= 2; id++ {
+		if err := q.Publish(ctx, &task.Task{ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case <-deliveries:
+		case <-time.After(time.Second):
+			t.Fatalf("expected 3 deliveries, got %d", i)
+		}
+	}
+
+	deadLetters, err := q.ListDeadLetters(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deadLetters) != 1 || deadLetters[0].Count != 1 {
+		t.Fatalf("expected 1 dead letter, got %v", deadLetters)
+	}
+	var deadTask task.Task
+	if err := json.Unmarshal([]byte(deadLetters[0].Body), &deadTask); err != nil || deadTask.ID != 2 {
+		t.Errorf("expected dead letter of task 2, got %s", deadLetters[0].Body)
+	}
+
+	// a replayed message is delivered again, and dead lettered again
+	if err := q.ReplayDeadLetter(ctx, deadLetters[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case id := <-deliveries:
+		if id != 2 {
+			t.Errorf("expected task 2 to be delivered, got %d", id)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected replayed message to be delivered")
+	}
+	deadLetter, err := q.GetDeadLetter(ctx, deadLetters[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deadLetter.Count != 2 {
+		t.Errorf("expected dead letter count to be 2, got %d", deadLetter.Count)
+	}
+
+	purged, err := q.PurgeDeadLetters(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 {
+		t.Errorf("expected 1 purged message, got %d", purged)
+	}
+	if _, err := q.GetDeadLetter(ctx, deadLetters[0].ID); err != task.ErrDeadLetterNotFound {
+		t.Errorf("expected %v, got %v", task.ErrDeadLetterNotFound, err)
+	}
+}
+
+func TestQueue_Shutdown(t *testing.T) {
+	ctx := context.Background()
+	q := New(100, 2)
+
+	started := sync.WaitGroup{}
+	started.Add(2)
+	release := make(chan struct{})
+	finished := make(chan struct{}, 2)
+	err := q.Consume(func(m task.Message) {
+		started.Done()
+		<-release
+		m.Ack()
+		finished <- struct{}{}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id := 1; id <= 2; id++ {
+		if err := q.Publish(ctx, &task.Task{ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	started.Wait()
+
+	// shutdown times out while the messages are being handled
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if err := q.Shutdown(timeoutCtx); err != context.DeadlineExceeded {
+		t.Errorf("expected shutdown to time out, got %v", err)
+	}
+	if err := q.Publish(ctx, &task.Task{ID: 3}); err != ErrClosed {
+		t.Errorf("expected publish after shutdown to fail with %v, got %v", ErrClosed, err)
+	}
+
+	close(release)
+	if err := q.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(finished) != 2 {
+		t.Errorf("expected 2 messages to be handled before shutdown returned, got %d", len(finished))
+	}
+}
@@ -3,35 +3,113 @@ package rabbitmq_queue
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/Av1shay/timers-scheduler-demo/task"
+	"github.com/google/uuid"
 	amqp "github.com/rabbitmq/amqp091-go"
+	"sync"
+	"time"
+)
+
+// maxDeadLetterScan is the max number of dead letters read when looking for a specific message
+const maxDeadLetterScan = 1000
+
+// DefaultConfirmTimeout is how long a publish or a batch waits for the broker to confirm its messages,
+// or less if the context of the publish has an earlier deadline
+const DefaultConfirmTimeout = 10 * time.Second
+
+var (
+	// ErrNacked is returned when the broker rejected a published message
+	ErrNacked = errors.New("message was nacked by the broker")
+	// ErrDisconnected is returned when the client is not connected to the broker, see State
+	ErrDisconnected = errors.New("not connected to RabbitMQ")
+)
+
+// DefaultWorkers and DefaultPrefetch are the number of messages handled concurrently by every consumer,
+// and the number of unacked messages the broker sends to the channel
+const (
+	DefaultWorkers  = 10
+	DefaultPrefetch = 2 * DefaultWorkers
 )
 
 type Client struct {
+	url            string
+	managementURL  string
+	queueName      string
+	deadQueueName  string
+	reconnectDelay time.Duration
+	workers        int
+	prefetch       int
+
+	mu    sync.RWMutex
+	conn  *amqp.Connection
 	ch    *amqp.Channel
-	queue *amqp.Queue
+	state State
+	// consumers are the handlers given to Consume, they are subscribed again after every reconnect
+	consumers []func(m task.Message)
+	// consumerTags are the subscriptions of the consumers on the current channel
+	consumerTags []string
+	// inFlight tracks the consumer workers, Shutdown waits for them to finish their messages
+	inFlight sync.WaitGroup
+	closed   chan struct{}
+
+	// deadLettersMu serializes the reads of the dead letter queue, every read holds the messages until they are requeued
+	deadLettersMu sync.Mutex
 }
 
-func New(ch *amqp.Channel, queueName string) (*Client, error) {
-	queue, err := ch.QueueDeclare(
-		queueName,
-		true, // durable - the queue won't lose items if the process crashes
-		false,
-		false,
-		false,
-		nil,
-	)
-	if err != nil {
-		return nil, err
+// Option configures optional behaviour of the Client
+type Option func(c *Client)
+
+// WithWorkers sets the number of messages handled concurrently by every consumer,
+// and the prefetch count (QoS) of the channel. A prefetch of 0 is unlimited
+func WithWorkers(workers, prefetch int) Option {
+	return func(c *Client) {
+		c.workers = workers
+		c.prefetch = prefetch
 	}
+}
 
-	return &Client{ch, &queue}, nil
+// Consume calls handler for every message from a pool of workers, so handler is called concurrently.
+// handler must ack the message or nack it without requeue to dead letter it.
+// The consumer is subscribed again whenever the client reconnects
+func (c *Client) Consume(handler func(m task.Message)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state == StateConnected {
+		if err := c.consume(c.ch, handler); err != nil {
+			return err
+		}
+	}
+	c.consumers = append(c.consumers, handler)
+	return nil
 }
 
-func (c *Client) Consume(cb func(d *amqp.Delivery)) error {
-	msgs, err := c.ch.Consume(
-		c.queue.Name,
-		"",
+// delivery adapts amqp.Delivery to task.Message
+type delivery struct {
+	d *amqp.Delivery
+}
+
+func (m delivery) Body() []byte {
+	return m.d.Body
+}
+
+func (m delivery) Ack() error {
+	return m.d.Ack(false)
+}
+
+func (m delivery) Nack(requeue bool) error {
+	return m.d.Nack(false, requeue)
+}
+
+// consume subscribes handler to the queue on ch, the subscription ends when ch is closed or cancelled by Shutdown.
+// c.mu must be held
+func (c *Client) consume(ch *amqp.Channel, handler func(m task.Message)) error {
+	tag := uuid.NewString()
+	msgs, err := ch.Consume(
+		c.queueName,
+		tag,
 		false,
 		false,
 		false,
@@ -41,29 +119,276 @@ func (c *Client) Consume(cb func(d *amqp.Delivery)) error {
 	if err != nil {
 		return err
 	}
+	c.consumerTags = append(c.consumerTags, tag)
+	c.work(msgs, handler)
+	return nil
+}
+
+// work calls handler for the deliveries of a subscription from a pool of workers, until msgs is closed.
+// Deliveries that are received after the client was closed are nacked with requeue instead
+func (c *Client) work(msgs <-chan amqp.Delivery, handler func(m task.Message)) {
+	c.inFlight.Add(c.workers)
+	for i := 0; i < c.workers; i++ {
+		go func() {
+			defer c.inFlight.Done()
+			// every message is acked or nacked by its own delivery tag, so workers don't depend on each other
+			for d := range msgs {
+				select {
+				case <-c.closed:
+					// prefetched messages that were not started yet are returned to the queue on shutdown
+					_ = d.Nack(false, true)
+					continue
+				default:
+				}
+				handler(delivery{&d})
+			}
+		}()
+	}
+}
 
+// drain waits for the workers to finish the messages they are handling until ctx is done
+func (c *Client) drain(ctx context.Context) error {
+	drained := make(chan struct{})
 	go func() {
-		for d := range msgs {
-			cb(&d)
-		}
+		c.inFlight.Wait()
+		close(drained)
 	}()
-
-	return nil
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("messages are still being handled: %w", ctx.Err())
+	}
 }
 
+// Publish publishes the task and waits for the broker to confirm it for up to DefaultConfirmTimeout.
+// Returns ErrNacked if the broker rejected the message
 func (c *Client) Publish(ctx context.Context, task *task.Task) error {
+	msg, err := newTaskPublishing(task)
+	if err != nil {
+		return err
+	}
+	return c.publish(ctx, msg)
+}
+
+// PublishBatch publishes all the tasks before waiting for their confirms, so a batch takes about a single round trip
+// to the broker. The whole batch waits for up to DefaultConfirmTimeout. Returns the error of every task,
+// nil when it was confirmed
+func (c *Client) PublishBatch(ctx context.Context, tasks []*task.Task) []error {
+	errs := make([]error, len(tasks))
+	confirms := make([]*deferredConfirmation, len(tasks))
+	for i, t := range tasks {
+		msg, err := newTaskPublishing(t)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		confirms[i], errs[i] = c.publishDeferred(ctx, msg)
+	}
+
+	ctx, cancel := withConfirmTimeout(ctx)
+	defer cancel()
+	for i, dc := range confirms {
+		if dc != nil {
+			errs[i] = waitConfirm(ctx, dc)
+		}
+	}
+	return errs
+}
+
+func newTaskPublishing(task *task.Task) (amqp.Publishing, error) {
 	b, err := json.Marshal(task)
+	if err != nil {
+		return amqp.Publishing{}, err
+	}
+	return amqp.Publishing{
+		DeliveryMode: amqp.Persistent,
+		ContentType:  "application/json",
+		MessageId:    uuid.NewString(),
+		Timestamp:    time.Now().UTC(),
+		Body:         b,
+	}, nil
+}
+
+// publish publishes the message and waits for its confirm
+func (c *Client) publish(ctx context.Context, msg amqp.Publishing) error {
+	dc, err := c.publishDeferred(ctx, msg)
 	if err != nil {
 		return err
 	}
-	return c.ch.PublishWithContext(ctx,
+	ctx, cancel := withConfirmTimeout(ctx)
+	defer cancel()
+	return waitConfirm(ctx, dc)
+}
+
+// publishDeferred publishes the message without waiting for its confirm, returns ErrDisconnected when the client
+// is not connected, so messages are never buffered in memory while the broker is down
+func (c *Client) publishDeferred(ctx context.Context, msg amqp.Publishing) (*deferredConfirmation, error) {
+	ch, err := c.channel()
+	if err != nil {
+		return nil, err
+	}
+	dc, err := ch.PublishWithDeferredConfirmWithContext(ctx,
 		"",
-		c.queue.Name,
+		c.queueName,
 		false,
 		false,
-		amqp.Publishing{
-			DeliveryMode: amqp.Persistent,
-			ContentType:  "application/json",
-			Body:         b,
-		})
+		msg)
+	if err != nil {
+		if ch.IsClosed() {
+			return nil, fmt.Errorf("%w: %v", ErrDisconnected, err)
+		}
+		return nil, err
+	}
+	return &deferredConfirmation{dc, ch}, nil
+}
+
+// deferredConfirmation is the confirm of a message with the channel it was published on
+type deferredConfirmation struct {
+	*amqp.DeferredConfirmation
+	ch *amqp.Channel
+}
+
+// waitConfirm waits for the broker to ack the message. Messages that were not confirmed when their channel closed
+// are nacked by the library, those return ErrDisconnected since the broker may have stored them
+func waitConfirm(ctx context.Context, dc *deferredConfirmation) error {
+	acked, err := dc.WaitContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed waiting for publish confirm: %w", err)
+	}
+	if !acked {
+		if dc.ch.IsClosed() {
+			return fmt.Errorf("%w: channel closed before the message was confirmed", ErrDisconnected)
+		}
+		return ErrNacked
+	}
+	return nil
+}
+
+// withConfirmTimeout bounds the wait for confirms, so a long context of the caller doesn't hold a publish
+// while the broker doesn't answer
+func withConfirmTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, DefaultConfirmTimeout)
+}
+
+// ListDeadLetters returns up to limit messages from the head of the dead letter queue
+func (c *Client) ListDeadLetters(_ context.Context, limit int) ([]*task.DeadLetter, error) {
+	c.deadLettersMu.Lock()
+	defer c.deadLettersMu.Unlock()
+
+	deliveries, err := c.getDeadLetters(limit, "")
+	defer c.requeue(deliveries)
+	if err != nil {
+		return nil, err
+	}
+	deadLetters := make([]*task.DeadLetter, len(deliveries))
+	for i, d := range deliveries {
+		deadLetters[i] = parseDeadLetter(d)
+	}
+	return deadLetters, nil
+}
+
+func (c *Client) GetDeadLetter(_ context.Context, id string) (*task.DeadLetter, error) {
+	c.deadLettersMu.Lock()
+	defer c.deadLettersMu.Unlock()
+
+	deliveries, err := c.getDeadLetters(maxDeadLetterScan, id)
+	defer c.requeue(deliveries)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 || deliveries[len(deliveries)-1].MessageId != id {
+		return nil, task.ErrDeadLetterNotFound
+	}
+	return parseDeadLetter(deliveries[len(deliveries)-1]), nil
+}
+
+// ReplayDeadLetter publishes the dead letter back to the queue and removes it from the dead letter queue
+func (c *Client) ReplayDeadLetter(ctx context.Context, id string) error {
+	c.deadLettersMu.Lock()
+	defer c.deadLettersMu.Unlock()
+
+	deliveries, err := c.getDeadLetters(maxDeadLetterScan, id)
+	if err != nil {
+		c.requeue(deliveries)
+		return err
+	}
+	if len(deliveries) == 0 || deliveries[len(deliveries)-1].MessageId != id {
+		c.requeue(deliveries)
+		return task.ErrDeadLetterNotFound
+	}
+	found := deliveries[len(deliveries)-1]
+	c.requeue(deliveries[:len(deliveries)-1])
+
+	err = c.publish(ctx, amqp.Publishing{
+		DeliveryMode: amqp.Persistent,
+		ContentType:  found.ContentType,
+		MessageId:    found.MessageId,
+		Timestamp:    time.Now().UTC(),
+		Body:         found.Body,
+	})
+	if err != nil {
+		c.requeue([]amqp.Delivery{found})
+		return err
+	}
+	return found.Ack(false)
+}
+
+// PurgeDeadLetters deletes all messages in the dead letter queue, returns the number of deleted messages
+func (c *Client) PurgeDeadLetters(_ context.Context) (int, error) {
+	ch, err := c.channel()
+	if err != nil {
+		return 0, err
+	}
+	return ch.QueuePurge(c.deadQueueName, false)
+}
+
+// getDeadLetters reads up to limit messages from the dead letter queue without acking them,
+// stops after the message with stopAtID if given. The messages must be acked or requeued by the caller
+func (c *Client) getDeadLetters(limit int, stopAtID string) ([]amqp.Delivery, error) {
+	deliveries := make([]amqp.Delivery, 0)
+	ch, err := c.channel()
+	if err != nil {
+		return deliveries, err
+	}
+	for len(deliveries) < limit {
+		d, ok, err := ch.Get(c.deadQueueName, false)
+		if err != nil {
+			return deliveries, err
+		}
+		if !ok {
+			break
+		}
+		deliveries = append(deliveries, d)
+		if stopAtID != "" && d.MessageId == stopAtID {
+			break
+		}
+	}
+	return deliveries, nil
+}
+
+func (c *Client) requeue(deliveries []amqp.Delivery) {
+	for _, d := range deliveries {
+		_ = d.Nack(false, true)
+	}
+}
+
+func parseDeadLetter(d amqp.Delivery) *task.DeadLetter {
+	deadLetter := &task.DeadLetter{
+		ID:   d.MessageId,
+		Body: string(d.Body),
+	}
+	// x-death is set by the broker, the first entry describes the last time the message was dead lettered
+	if deaths, ok := d.Headers["x-death"].([]interface{}); ok && len(deaths) > 0 {
+		if death, ok := deaths[0].(amqp.Table); ok {
+			deadLetter.Reason = fmt.Sprint(death["reason"])
+			if count, ok := death["count"].(int64); ok {
+				deadLetter.Count = int(count)
+			}
+			if t, ok := death["time"].(time.Time); ok {
+				deadLetter.DeadAt = t.UTC()
+			}
+		}
+	}
+	return deadLetter
 }
@@ -0,0 +1,160 @@
+package rabbitmq_queue
+
+import (
+	"context"
+	"errors"
+	"github.com/Av1shay/timers-scheduler-demo/task"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mockAcknowledger records the acks and nacks of deliveries by their tag
+type mockAcknowledger struct {
+	mu       sync.Mutex
+	acked    []uint64
+	requeued []uint64
+	rejected []uint64
+}
+
+func (a *mockAcknowledger) Ack(tag uint64, _ bool) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.acked = append(a.acked, tag)
+	return nil
+}
+
+func (a *mockAcknowledger) Nack(tag uint64, _ bool, requeue bool) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if requeue {
+		a.requeued = append(a.requeued, tag)
+	} else {
+		a.rejected = append(a.rejected, tag)
+	}
+	return nil
+}
+
+func (a *mockAcknowledger) Reject(tag uint64, requeue bool) error {
+	return a.Nack(tag, false, requeue)
+}
+
+func newTestClient(workers int) *Client {
+	return &Client{workers: workers, closed: make(chan struct{})}
+}
+
+func TestClient_WorkConcurrently(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(3)
+	acker := &mockAcknowledger{}
+
+	msgs := make(chan amqp.Delivery, 3)
+	for tag := uint64(1); tag <= 3; tag++ {
+		msgs <- amqp.Delivery{Acknowledger: acker, DeliveryTag: tag}
+	}
+
+	started := sync.WaitGroup{}
+	started.Add(3)
+	release := make(chan struct{})
+	c.work(msgs, func(m task.Message) {
+		started.Done()
+		<-release
+		m.Ack()
+	})
+
+	// every worker holds a message, so the handlers can only return once all of them started
+	allStarted := make(chan struct{})
+	go func() {
+		started.Wait()
+		close(allStarted)
+	}()
+	select {
+	case <-allStarted:
+	case <-time.After(time.Second):
+		t.Fatal("expected 3 handlers to run concurrently")
+	}
+	close(release)
+	close(msgs)
+	if err := c.drain(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	acker.mu.Lock()
+	defer acker.mu.Unlock()
+	acked := make(map[uint64]bool)
+	for _, tag := range acker.acked {
+		acked[tag] = true
+	}
+	if len(acked) != 3 || len(acker.acked) != 3 || len(acker.requeued) != 0 {
+		t.Errorf("expected every message to be acked once by its own tag, got %v", acker.acked)
+	}
+}
+
+func TestClient_WorkShutdown(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(1)
+	acker := &mockAcknowledger{}
+
+	// the first message is being handled, the next two were prefetched
+	msgs := make(chan amqp.Delivery, 3)
+	for tag := uint64(1); tag <= 3; tag++ {
+		msgs <- amqp.Delivery{Acknowledger: acker, DeliveryTag: tag, Body: []byte("{}")}
+	}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handled := 0
+	c.work(msgs, func(m task.Message) {
+		handled++
+		close(started)
+		<-release
+		m.Ack()
+	})
+	<-started
+	close(c.closed)
+
+	// draining waits for the running handler
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if err := c.drain(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected drain to time out while a message is handled, got %v", err)
+	}
+
+	close(release)
+	close(msgs)
+	if err := c.drain(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if handled != 1 {
+		t.Errorf("expected only the started message to be handled, got %d", handled)
+	}
+	acker.mu.Lock()
+	defer acker.mu.Unlock()
+	if len(acker.acked) != 1 || acker.acked[0] != 1 {
+		t.Errorf("expected the started message to be acked, got %v", acker.acked)
+	}
+	if len(acker.requeued) != 2 || acker.requeued[0] != 2 || acker.requeued[1] != 3 {
+		t.Errorf("expected the prefetched messages to be requeued, got %v", acker.requeued)
+	}
+}
+
+func TestWithConfirmTimeout(t *testing.T) {
+	// the confirm timeout applies even when the caller has a longer deadline
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	confirmCtx, confirmCancel := withConfirmTimeout(ctx)
+	defer confirmCancel()
+	deadline, ok := confirmCtx.Deadline()
+	if !ok || time.Until(deadline) > DefaultConfirmTimeout {
+		t.Errorf("expected a deadline within %s, got %s", DefaultConfirmTimeout, deadline)
+	}
+
+	// and a shorter deadline of the caller is kept
+	shortCtx, shortCancel := context.WithTimeout(context.Background(), time.Second)
+	defer shortCancel()
+	confirmCtx, confirmCancel = withConfirmTimeout(shortCtx)
+	defer confirmCancel()
+	if deadline, _ := confirmCtx.Deadline(); time.Until(deadline) > time.Second {
+		t.Errorf("expected the deadline of the caller to be kept, got %s", deadline)
+	}
+}
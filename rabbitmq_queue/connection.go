@@ -0,0 +1,298 @@
+package rabbitmq_queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Av1shay/timers-scheduler-demo/logx"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"time"
+)
+
+const (
+	// DefaultReconnectDelay is the delay before the first reconnect attempt, it's doubled after every failed attempt
+	DefaultReconnectDelay = time.Second
+	maxReconnectDelay     = 30 * time.Second
+)
+
+// State is the state of the connection to the broker
+type State int
+
+const (
+	StateConnected State = iota
+	// StateReconnecting means the connection was lost, publishes fail with ErrDisconnected until it's back
+	StateReconnecting
+	StateClosed
+)
+
+func (s State) String() string {
+	switch s {
+	case StateConnected:
+		return "connected"
+	case StateReconnecting:
+		return "reconnecting"
+	case StateClosed:
+		return "closed"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// errClientClosed is returned by setConnected when the client was closed while it was reconnecting
+var errClientClosed = errors.New("client is closed")
+
+// connection is an open connection with its channel, with the notifications of their closing
+type connection struct {
+	conn       *amqp.Connection
+	ch         *amqp.Channel
+	connClosed <-chan *amqp.Error
+	chClosed   <-chan *amqp.Error
+}
+
+// Dial connects to the broker and declares the queue and its dead letter exchange and queue. Messages that are
+// rejected by the consumer are routed to the dead letter queue by a policy, which is applied through the management API
+// when WithManagementURL is given and has to be set on the broker otherwise.
+// The client reconnects with backoff whenever the connection or channel is closed, until it's shut down
+func Dial(url, queueName string, opts ...Option) (*Client, error) {
+	c := &Client{
+		url:            url,
+		queueName:      queueName,
+		deadQueueName:  queueName + ".dead-letter",
+		reconnectDelay: DefaultReconnectDelay,
+		workers:        DefaultWorkers,
+		prefetch:       DefaultPrefetch,
+		closed:         make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	conn, err := c.connect()
+	if err != nil {
+		return nil, err
+	}
+	if c.managementURL != "" {
+		if err := c.applyDeadLetterPolicy(context.Background()); err != nil {
+			_ = conn.conn.Close()
+			return nil, fmt.Errorf("failed to apply dead letter policy: %w", err)
+		}
+	}
+	c.conn, c.ch, c.state = conn.conn, conn.ch, StateConnected
+
+	go c.watch(conn)
+	return c, nil
+}
+
+// State returns the current state of the connection
+func (c *Client) State() State {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state
+}
+
+// Healthy returns an error when the client is not connected to the broker
+func (c *Client) Healthy() error {
+	if state := c.State(); state != StateConnected {
+		return fmt.Errorf("rabbitmq is %s", state)
+	}
+	return nil
+}
+
+// Shutdown stops consuming, waits for the messages that are being handled until ctx is done, and closes the connection.
+// Messages that were prefetched but not started are returned to the queue
+func (c *Client) Shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	if c.state == StateClosed {
+		c.mu.Unlock()
+		return nil
+	}
+	close(c.closed)
+	c.state = StateClosed
+	conn, ch, tags := c.conn, c.ch, c.consumerTags
+	c.mu.Unlock()
+
+	// the broker stops sending messages, the workers exit once the deliveries channels are closed
+	for _, tag := range tags {
+		_ = ch.Cancel(tag, false)
+	}
+	err := c.drain(ctx)
+	if closeErr := conn.Close(); closeErr != nil && !errors.Is(closeErr, amqp.ErrClosed) && err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Close shuts down the client without a deadline for the messages that are being handled
+func (c *Client) Close() error {
+	return c.Shutdown(context.Background())
+}
+
+// channel returns the channel of the current connection, or ErrDisconnected while reconnecting
+func (c *Client) channel() (*amqp.Channel, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.state != StateConnected {
+		return nil, fmt.Errorf("%w: connection is %s", ErrDisconnected, c.state)
+	}
+	return c.ch, nil
+}
+
+// watch reconnects whenever the connection is closed by the broker or the network, until the client is closed
+func (c *Client) watch(conn *connection) {
+	ctx := context.Background()
+	for {
+		select {
+		case <-c.closed:
+			return
+		case amqpErr := <-conn.connClosed:
+			logx.Error(ctx, "rabbitmq connection closed:", amqpErr)
+		case amqpErr := <-conn.chClosed:
+			logx.Error(ctx, "rabbitmq channel closed:", amqpErr)
+		}
+
+		c.mu.Lock()
+		if c.state == StateClosed {
+			c.mu.Unlock()
+			return
+		}
+		c.state = StateReconnecting
+		c.mu.Unlock()
+		// the connection may still be open when only the channel was closed
+		_ = conn.conn.Close()
+
+		conn = c.reconnect(ctx)
+		if conn == nil {
+			return
+		}
+		logx.Info(ctx, "reconnected to rabbitmq")
+	}
+}
+
+// reconnect connects with exponential backoff and subscribes the consumers again,
+// returns nil if the client was closed before it connected
+func (c *Client) reconnect(ctx context.Context) *connection {
+	delay := c.reconnectDelay
+	for {
+		select {
+		case <-c.closed:
+			return nil
+		case <-time.After(delay):
+		}
+
+		conn, err := c.connect()
+		if err == nil {
+			if err = c.setConnected(conn); err != nil {
+				_ = conn.conn.Close()
+			}
+		}
+		if err == nil {
+			return conn
+		}
+		if err == errClientClosed {
+			return nil
+		}
+
+		logx.Errorf(ctx, "failed to reconnect to rabbitmq, retrying in %s: %v", delay, err)
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// setConnected makes conn the current connection and subscribes the consumers on it
+func (c *Client) setConnected(conn *connection) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state == StateClosed {
+		_ = conn.conn.Close()
+		return errClientClosed
+	}
+	c.consumerTags = nil
+	for _, handler := range c.consumers {
+		if err := c.consume(conn.ch, handler); err != nil {
+			return err
+		}
+	}
+	c.conn, c.ch, c.state = conn.conn, conn.ch, StateConnected
+	return nil
+}
+
+// connect opens a connection and a channel in confirm mode, and declares the queues
+func (c *Client) connect() (*connection, error) {
+	conn, err := amqp.Dial(c.url)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	// register before anything else so a close during the setup is not missed
+	connClosed := conn.NotifyClose(make(chan *amqp.Error, 1))
+	chClosed := ch.NotifyClose(make(chan *amqp.Error, 1))
+
+	if err := c.declare(ch); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	// in confirm mode the broker acks every published message once it's stored, see Publish
+	if err := ch.Confirm(false); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to put channel into confirm mode: %w", err)
+	}
+	if err := ch.Qos(c.prefetch, 0, false); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return &connection{conn: conn, ch: ch, connClosed: connClosed, chClosed: chClosed}, nil
+}
+
+// dlxName is the name of the dead letter exchange of the queue, it's bound to the dead letter queue
+func (c *Client) dlxName() string {
+	return c.queueName + ".dlx"
+}
+
+// declare declares the queue with its dead letter exchange and queue, it's idempotent.
+// The queue is declared without arguments so queues created by older versions are declared as is,
+// the dead letter exchange is set by a policy, see applyDeadLetterPolicy
+func (c *Client) declare(ch *amqp.Channel) error {
+	dlxName := c.dlxName()
+	err := ch.ExchangeDeclare(
+		dlxName,
+		amqp.ExchangeFanout,
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = ch.QueueDeclare(
+		c.deadQueueName,
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	if err := ch.QueueBind(c.deadQueueName, "", dlxName, false, nil); err != nil {
+		return err
+	}
+
+	_, err = ch.QueueDeclare(
+		c.queueName,
+		true, // durable - the queue won't lose items if the process crashes
+		false,
+		false,
+		false,
+		nil,
+	)
+	return err
+}
@@ -0,0 +1,420 @@
+// Package redis_queue is a queue backend on Redis Streams. Every queue is a stream consumed by a consumer group, so
+// every message is delivered to one of the consumers of the group. Messages that were delivered and not acked within
+// the claim timeout, because their consumer crashed, are claimed by another consumer with XAUTOCLAIM
+package redis_queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Av1shay/timers-scheduler-demo/logx"
+	"github.com/Av1shay/timers-scheduler-demo/task"
+	"github.com/Av1shay/timers-scheduler-demo/workerpool"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultClaimTimeout has to be longer than the time it takes to handle a message
+	DefaultClaimTimeout = time.Minute
+	// DefaultMaxDeliveries is the number of times a message is delivered before it's dead lettered
+	DefaultMaxDeliveries = 5
+	// DefaultMaxLen is the approximate number of entries the stream is trimmed to when messages are added,
+	// it must be much larger than the number of pending messages since trimmed messages are dropped
+	DefaultMaxLen = 100000
+
+	groupName = "scheduler"
+	// readBlock is how long a read waits for new messages, it bounds the time Shutdown waits for the consumer
+	readBlock = time.Second
+)
+
+// ErrNotPending is returned when acking or nacking a message that was already acked
+var ErrNotPending = errors.New("message is not pending")
+
+type Queue struct {
+	rdb           *redis.Client
+	stream        string
+	deadStream    string
+	consumer      string
+	workers       int
+	claimTimeout  time.Duration
+	maxDeliveries int64
+	maxLen        int64
+
+	pool *workerpool.Pool
+}
+
+// Option configures optional behaviour of the Queue
+type Option func(q *Queue)
+
+// WithWorkers sets the number of messages handled concurrently by every consumer
+func WithWorkers(workers int) Option {
+	return func(q *Queue) {
+		q.workers = workers
+	}
+}
+
+// WithClaimTimeout sets for how long a delivered message is not acked before it's claimed by another consumer
+func WithClaimTimeout(d time.Duration) Option {
+	return func(q *Queue) {
+		q.claimTimeout = d
+	}
+}
+
+// WithMaxDeliveries sets the number of times a message is delivered before it's dead lettered
+func WithMaxDeliveries(n int) Option {
+	return func(q *Queue) {
+		q.maxDeliveries = int64(n)
+	}
+}
+
+// WithMaxLen sets the approximate number of entries the stream is trimmed to
+func WithMaxLen(n int64) Option {
+	return func(q *Queue) {
+		q.maxLen = n
+	}
+}
+
+// New creates the stream and the consumer group of the queue if they don't exist. consumer is the name of the
+// instance in the group, it has to be unique. The messages a consumer left pending when it stopped are claimed with
+// XAUTOCLAIM after the claim timeout, by whichever consumer reads next, so the name doesn't have to survive restarts
+func New(ctx context.Context, rdb *redis.Client, queueName, consumer string, opts ...Option) (*Queue, error) {
+	q := &Queue{
+		rdb:           rdb,
+		stream:        queueName,
+		deadStream:    queueName + ".dead-letter",
+		consumer:      consumer,
+		workers:       workerpool.DefaultWorkers,
+		claimTimeout:  DefaultClaimTimeout,
+		maxDeliveries: DefaultMaxDeliveries,
+		maxLen:        DefaultMaxLen,
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+	// reads block until messages arrive, so the pool doesn't have to wait between them
+	q.pool = workerpool.New(q.workers, 0)
+	err := rdb.XGroupCreateMkStream(ctx, q.stream, groupName, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return nil, fmt.Errorf("failed to create consumer group: %w", err)
+	}
+	return q, nil
+}
+
+func (q *Queue) Publish(ctx context.Context, t *task.Task) error {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	return q.rdb.XAdd(ctx, q.add(q.stream, map[string]interface{}{"body": b})).Err()
+}
+
+// PublishBatch adds the messages of all the tasks in a single round trip
+func (q *Queue) PublishBatch(ctx context.Context, tasks []*task.Task) []error {
+	errs := make([]error, len(tasks))
+	cmds := make([]*redis.StringCmd, len(tasks))
+	_, _ = q.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, t := range tasks {
+			b, err := json.Marshal(t)
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			cmds[i] = pipe.XAdd(ctx, q.add(q.stream, map[string]interface{}{"body": b}))
+		}
+		return nil
+	})
+	for i, cmd := range cmds {
+		if cmd != nil {
+			errs[i] = cmd.Err()
+		}
+	}
+	return errs
+}
+
+// add returns the arguments of adding values to stream, trimming it to about the max length
+func (q *Queue) add(stream string, values map[string]interface{}) *redis.XAddArgs {
+	return &redis.XAddArgs{Stream: stream, MaxLen: q.maxLen, Approx: true, Values: values}
+}
+
+// Healthy returns an error when Redis can't be reached
+func (q *Queue) Healthy() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return q.rdb.Ping(ctx).Err()
+}
+
+// Consume calls handler for every message from a pool of workers, so handler is called concurrently.
+// handler must ack the message or nack it without requeue to dead letter it.
+// Messages are read only when a worker is idle, so their claim timeout starts when they are handled
+func (q *Queue) Consume(handler func(m task.Message)) error {
+	// claimStart is the cursor of XAUTOCLAIM, it goes over the pending messages and wraps around
+	claimStart := "0-0"
+	return q.pool.Consume(func(ctx context.Context, n int) ([]task.Message, error) {
+		var msgs []*message
+		var err error
+		msgs, claimStart, err = q.claim(ctx, claimStart, n)
+		if err != nil {
+			logx.Error(ctx, "failed to claim pending messages:", err)
+		}
+		if len(msgs) < n {
+			read, err := q.read(ctx, n-len(msgs))
+			if err != nil {
+				return toMessages(msgs), err
+			}
+			msgs = append(msgs, read...)
+		}
+		return toMessages(msgs), nil
+	}, handler)
+}
+
+// claim takes over up to count messages that were not acked within the claim timeout, starting at the start cursor.
+// Messages that were delivered the max times are dead lettered instead, and messages that were trimmed are acked.
+// Returns the cursor of the next claim
+func (q *Queue) claim(ctx context.Context, start string, count int) ([]*message, string, error) {
+	claimed, next, err := q.rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   q.stream,
+		Group:    groupName,
+		MinIdle:  q.claimTimeout,
+		Start:    start,
+		Count:    int64(count),
+		Consumer: q.consumer,
+	}).Result()
+	if err != nil {
+		return nil, start, err
+	}
+	if len(claimed) == 0 {
+		return nil, next, nil
+	}
+
+	// XAUTOCLAIM doesn't return the delivery counts, they are read from the pending entries
+	pending := make([]*redis.XPendingExtCmd, len(claimed))
+	_, err = q.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, xm := range claimed {
+			pending[i] = pipe.XPendingExt(ctx, &redis.XPendingExtArgs{
+				Stream: q.stream,
+				Group:  groupName,
+				Start:  xm.ID,
+				End:    xm.ID,
+				Count:  1,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, next, err
+	}
+
+	msgs := make([]*message, 0, len(claimed))
+	for i, xm := range claimed {
+		m := q.parseMessage(xm)
+		if m.body == nil {
+			// the message was trimmed from the stream before it was acked
+			logx.Error(ctx, "dropping trimmed message", xm.ID)
+			_ = q.rdb.XAck(ctx, q.stream, groupName, xm.ID).Err()
+			continue
+		}
+		if entries := pending[i].Val(); len(entries) == 1 && entries[0].RetryCount > q.maxDeliveries {
+			reason := fmt.Sprintf("delivered %d times without ack", q.maxDeliveries)
+			if err := m.deadLetter(ctx, reason); err != nil {
+				logx.Errorf(ctx, "failed to dead letter message %s: %v", m.id, err)
+			}
+			continue
+		}
+		msgs = append(msgs, m)
+	}
+	return msgs, next, nil
+}
+
+// read reads up to count new messages of the group
+func (q *Queue) read(ctx context.Context, count int) ([]*message, error) {
+	streams, err := q.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    groupName,
+		Consumer: q.consumer,
+		Streams:  []string{q.stream, ">"},
+		Count:    int64(count),
+		Block:    readBlock,
+	}).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	msgs := make([]*message, 0, count)
+	for _, s := range streams {
+		for _, xm := range s.Messages {
+			msgs = append(msgs, q.parseMessage(xm))
+		}
+	}
+	return msgs, nil
+}
+
+// Shutdown stops consuming and waits for the messages that are being handled until ctx is done.
+// Messages that were read and not acked are claimed again by another consumer after the claim timeout
+func (q *Queue) Shutdown(ctx context.Context) error {
+	return q.pool.Shutdown(ctx)
+}
+
+// ListDeadLetters returns up to limit messages from the head of the dead letter stream
+func (q *Queue) ListDeadLetters(ctx context.Context, limit int) ([]*task.DeadLetter, error) {
+	xms, err := q.rdb.XRangeN(ctx, q.deadStream, "-", "+", int64(limit)).Result()
+	if err != nil {
+		return nil, err
+	}
+	deadLetters := make([]*task.DeadLetter, len(xms))
+	for i, xm := range xms {
+		deadLetters[i] = parseDeadLetter(xm)
+	}
+	return deadLetters, nil
+}
+
+func (q *Queue) GetDeadLetter(ctx context.Context, id string) (*task.DeadLetter, error) {
+	xm, err := q.getDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return parseDeadLetter(*xm), nil
+}
+
+// ReplayDeadLetter adds the dead letter back to the stream and removes it from the dead letter stream
+func (q *Queue) ReplayDeadLetter(ctx context.Context, id string) error {
+	xm, err := q.getDeadLetter(ctx, id)
+	if err != nil {
+		return err
+	}
+	_, err = q.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAdd(ctx, q.add(q.stream, map[string]interface{}{"body": xm.Values["body"], "deaths": xm.Values["deaths"]}))
+		pipe.XDel(ctx, q.deadStream, id)
+		return nil
+	})
+	return err
+}
+
+// PurgeDeadLetters deletes the dead letter stream, returns the number of deleted messages
+func (q *Queue) PurgeDeadLetters(ctx context.Context) (int, error) {
+	var count *redis.IntCmd
+	_, err := q.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		count = pipe.XLen(ctx, q.deadStream)
+		pipe.Del(ctx, q.deadStream)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count.Val()), nil
+}
+
+func (q *Queue) getDeadLetter(ctx context.Context, id string) (*redis.XMessage, error) {
+	xms, err := q.rdb.XRangeN(ctx, q.deadStream, id, id, 1).Result()
+	if err != nil {
+		// an id that is not a valid stream id
+		if strings.HasPrefix(err.Error(), "ERR Invalid stream ID") {
+			return nil, task.ErrDeadLetterNotFound
+		}
+		return nil, err
+	}
+	if len(xms) == 0 {
+		return nil, task.ErrDeadLetterNotFound
+	}
+	return &xms[0], nil
+}
+
+// message is a message read by the consumer of the group, it stays pending until it's acked
+type message struct {
+	id   string
+	body []byte
+	// deaths is the number of times the message was dead lettered and replayed
+	deaths int
+	q      *Queue
+}
+
+func toMessages(msgs []*message) []task.Message {
+	converted := make([]task.Message, len(msgs))
+	for i, m := range msgs {
+		converted[i] = m
+	}
+	return converted
+}
+
+func (q *Queue) parseMessage(xm redis.XMessage) *message {
+	m := &message{id: xm.ID, q: q}
+	if body, ok := xm.Values["body"].(string); ok {
+		m.body = []byte(body)
+	}
+	if deaths, ok := xm.Values["deaths"].(string); ok {
+		m.deaths, _ = strconv.Atoi(deaths)
+	}
+	return m
+}
+
+func (m *message) Body() []byte {
+	return m.body
+}
+
+func (m *message) Ack() error {
+	n, err := m.q.rdb.XAck(context.Background(), m.q.stream, groupName, m.id).Result()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotPending
+	}
+	return nil
+}
+
+// Nack adds the message again at the tail of the stream when requeue is true, and adds it to the dead letter stream
+// otherwise. Streams can't return a message to the group, so the message is acked either way
+func (m *message) Nack(requeue bool) error {
+	ctx := context.Background()
+	if !requeue {
+		return m.deadLetter(ctx, "rejected")
+	}
+	return m.moveTo(ctx, m.q.stream, map[string]interface{}{"body": m.body, "deaths": m.deaths})
+}
+
+func (m *message) deadLetter(ctx context.Context, reason string) error {
+	return m.moveTo(ctx, m.q.deadStream, map[string]interface{}{
+		"body":   m.body,
+		"deaths": m.deaths + 1,
+		"reason": reason,
+		"deadAt": time.Now().UTC().Format(time.RFC3339Nano),
+	})
+}
+
+// moveTo adds values to stream and acks the message in a single transaction
+func (m *message) moveTo(ctx context.Context, stream string, values map[string]interface{}) error {
+	var ack *redis.IntCmd
+	_, err := m.q.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAdd(ctx, m.q.add(stream, values))
+		ack = pipe.XAck(ctx, m.q.stream, groupName, m.id)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if ack.Val() == 0 {
+		return ErrNotPending
+	}
+	return nil
+}
+
+func parseDeadLetter(xm redis.XMessage) *task.DeadLetter {
+	deadLetter := &task.DeadLetter{ID: xm.ID}
+	if body, ok := xm.Values["body"].(string); ok {
+		deadLetter.Body = body
+	}
+	if reason, ok := xm.Values["reason"].(string); ok {
+		deadLetter.Reason = reason
+	}
+	if deaths, ok := xm.Values["deaths"].(string); ok {
+		deadLetter.Count, _ = strconv.Atoi(deaths)
+	}
+	if deadAt, ok := xm.Values["deadAt"].(string); ok {
+		deadLetter.DeadAt, _ = time.Parse(time.RFC3339Nano, deadAt)
+	}
+	return deadLetter
+}
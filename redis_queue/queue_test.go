@@ -0,0 +1,256 @@
+package redis_queue
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/Av1shay/timers-scheduler-demo/task"
+	"github.com/Av1shay/timers-scheduler-demo/workerpool"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"sync"
+	"testing"
+	"time"
+)
+
+// openQueue opens a queue on an in process redis
+func openQueue(t *testing.T, opts ...Option) (*Queue, *redis.Client) {
+	ctx := context.Background()
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	q, err := New(ctx, rdb, "test", "consumer-1", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		q.Shutdown(ctx)
+		rdb.Close()
+	})
+	return q, rdb
+}
+
+func TestQueue_PublishConsume(t *testing.T) {
+	ctx := context.Background()
+	q, rdb := openQueue(t, WithWorkers(4))
+
+	tasks := make([]*task.Task, 20)
+	for i := range tasks {
+		tasks[i] = &task.Task{ID: i + 1}
+	}
+	for _, err := range q.PublishBatch(ctx, tasks[:10]) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mu := sync.Mutex{}
+	received := make(map[int]int)
+	done := make(chan struct{}, len(tasks))
+	err := q.Consume(func(m task.Message) {
+		var ta task.Task
+		if err := json.Unmarshal(m.Body(), &ta); err != nil {
+			t.Error(err)
+		}
+		if err := m.Ack(); err != nil {
+			t.Error(err)
+		}
+		if err := m.Ack(); err != ErrNotPending {
+			t.Errorf("expected second ack to fail with %v, got %v", ErrNotPending, err)
+		}
+		mu.Lock()
+		received[ta.ID]++
+		mu.Unlock()
+		done <- struct{}{}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ta := range tasks[10:] {
+		if err := q.Publish(ctx, ta); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := range tasks {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %d messages, got %d", len(tasks), i)
+		}
+	}
+	if err := q.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Consume(func(m task.Message) {}); err != workerpool.ErrClosed {
+		t.Errorf("expected consume after shutdown to fail with %v, got %v", workerpool.ErrClosed, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, ta := range tasks {
+		if received[ta.ID] != 1 {
+			t.Errorf("expected task %d to be received once, got %d", ta.ID, received[ta.ID])
+		}
+	}
+	pending, err := rdb.XPending(ctx, q.stream, groupName).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending.Count != 0 {
+		t.Errorf("expected no pending messages, got %d", pending.Count)
+	}
+}
+
+func TestQueue_ClaimTimeout(t *testing.T) {
+	ctx := context.Background()
+	q, _ := openQueue(t, WithClaimTimeout(100*time.Millisecond), WithMaxDeliveries(2))
+
+	if err := q.Publish(ctx, &task.Task{ID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	msgs, err := q.read(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 {
+		t.Fatalf("expected to read 1 message, got %d", len(msgs))
+	}
+
+	// the message is claimed once it wasn't acked within the claim timeout
+	claimed, _, err := q.claim(ctx, "0-0", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(claimed) != 0 {
+		t.Fatalf("expected unacked message not to be claimed yet, got %d messages", len(claimed))
+	}
+	time.Sleep(150 * time.Millisecond)
+	claimed, _, err = q.claim(ctx, "0-0", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(claimed) != 1 || claimed[0].id != msgs[0].id {
+		t.Fatalf("expected to claim message %s, got %v", msgs[0].id, claimed)
+	}
+
+	// after the max deliveries the message is dead lettered
+	time.Sleep(150 * time.Millisecond)
+	claimed, _, err = q.claim(ctx, "0-0", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(claimed) != 0 {
+		t.Fatalf("expected message to be dead lettered, got %d messages", len(claimed))
+	}
+	if err := msgs[0].Ack(); err != ErrNotPending {
+		t.Errorf("expected ack of the dead lettered message to fail with %v, got %v", ErrNotPending, err)
+	}
+	deadLetters, err := q.ListDeadLetters(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deadLetters) != 1 || deadLetters[0].Count != 1 {
+		t.Fatalf("expected 1 dead letter, got %v", deadLetters)
+	}
+	var deadTask task.Task
+	if err := json.Unmarshal([]byte(deadLetters[0].Body), &deadTask); err != nil || deadTask.ID != 1 {
+		t.Errorf("expected dead letter of task 1, got %s", deadLetters[0].Body)
+	}
+
+	// a replayed message is read again as a new message
+	if err := q.ReplayDeadLetter(ctx, deadLetters[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	msgs, err = q.read(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 || msgs[0].deaths != 1 {
+		t.Fatalf("expected to read the replayed message, got %v", msgs)
+	}
+	if err := msgs[0].Ack(); err != nil {
+		t.Error(err)
+	}
+	if _, err := q.GetDeadLetter(ctx, deadLetters[0].ID); err != task.ErrDeadLetterNotFound {
+		t.Errorf("expected %v, got %v", task.ErrDeadLetterNotFound, err)
+	}
+}
+
+func TestQueue_Nack(t *testing.T) {
+	ctx := context.Background()
+	q, _ := openQueue(t)
+
+	for id := 1; id <= 2; id++ {
+		if err := q.Publish(ctx, &task.Task{ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	msgs, err := q.read(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("expected to read 2 messages, got %d", len(msgs))
+	}
+	if err := msgs[0].Nack(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := msgs[1].Nack(false); err != nil {
+		t.Fatal(err)
+	}
+
+	// the requeued message is read again, the rejected one is dead lettered
+	requeued, err := q.read(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requeued) != 1 || string(requeued[0].body) != string(msgs[0].body) {
+		t.Fatalf("expected to read the requeued message, got %v", requeued)
+	}
+	deadLetters, err := q.ListDeadLetters(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deadLetters) != 1 {
+		t.Fatalf("expected 1 dead letter, got %d", len(deadLetters))
+	}
+	deadLetter, err := q.GetDeadLetter(ctx, deadLetters[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deadLetter.Reason != "rejected" {
+		t.Errorf("expected dead letter reason to be rejected, got %q", deadLetter.Reason)
+	}
+	if _, err := q.GetDeadLetter(ctx, "not-an-id"); err != task.ErrDeadLetterNotFound {
+		t.Errorf("expected %v, got %v", task.ErrDeadLetterNotFound, err)
+	}
+
+	purged, err := q.PurgeDeadLetters(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 {
+		t.Errorf("expected 1 purged message, got %d", purged)
+	}
+	if _, err := q.GetDeadLetter(ctx, deadLetters[0].ID); err != task.ErrDeadLetterNotFound {
+		t.Errorf("expected %v, got %v", task.ErrDeadLetterNotFound, err)
+	}
+}
+
+func TestQueue_MaxLen(t *testing.T) {
+	ctx := context.Background()
+	q, rdb := openQueue(t, WithMaxLen(5))
+
+	for id := 1; id <= 20; id++ {
+		if err := q.Publish(ctx, &task.Task{ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	n, err := rdb.XLen(ctx, q.stream).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n > 5 {
+		t.Errorf("expected the stream to be trimmed to 5 messages, got %d", n)
+	}
+}
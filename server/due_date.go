@@ -0,0 +1,185 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // embedded zone database, for resolving timeZone on hosts without one
+)
+
+// pastTolerance allows due dates slightly in the past, to absorb clock skew between the client and the server
+const pastTolerance = time.Second
+
+// maxDueDate is the latest date the dueDate column can store, it's a MySQL TIMESTAMP
+var maxDueDate = time.Date(2038, 1, 19, 3, 14, 7, 999000000, time.UTC)
+
+// errDurationTooLong is returned for durations that don't fit in a time.Duration, about 292 years
+var errDurationTooLong = errors.New("duration is too long")
+
+// isoDurationRegex matches ISO-8601 durations of weeks, days, hours, minutes and seconds (e.g. P1DT2H30M or PT0.5S).
+// Years and months are not supported since their length depends on the calendar
+var isoDurationRegex = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// resolveDueDate returns the due date of a new timer, relative to n. Only one of hours/minutes/seconds/milliseconds, duration,
+// dueAt and cron can be given, returns zero time for cron timers since their due date is set by the task service
+func resolveDueDate(req *SetTimerReq, n time.Time) (time.Time, error) {
+	offset, err := offsetDuration(req.Hours, req.Minutes, req.Seconds, req.Milliseconds)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	given := 0
+	for _, isSet := range []bool{offset > 0, req.Duration != "", req.DueAt != "", req.Cron != ""} {
+		if isSet {
+			given++
+		}
+	}
+	if given > 1 {
+		return time.Time{}, errors.New("only one of hours/minutes/seconds/milliseconds, duration, dueAt and cron can be given")
+	}
+	if req.TimeZone != "" && req.DueAt == "" {
+		return time.Time{}, errors.New("timeZone can be given only with dueAt")
+	}
+
+	var dueDate time.Time
+	switch {
+	case req.Cron != "":
+		return time.Time{}, nil
+	case req.Duration != "":
+		d, err := parseISODuration(req.Duration)
+		if err != nil {
+			return time.Time{}, err
+		}
+		dueDate = n.Add(d)
+	case req.DueAt != "":
+		var err error
+		dueDate, err = parseDueAt(req.DueAt, req.TimeZone)
+		if err != nil {
+			return time.Time{}, err
+		}
+	default:
+		dueDate = n.Add(offset)
+	}
+
+	if dueDate.Before(n.Add(-pastTolerance)) {
+		if !req.AllowPast {
+			return time.Time{}, fmt.Errorf("due date %s is in the past, set allowPast to fire it right away", dueDate.Format(time.RFC3339))
+		}
+		dueDate = n
+	}
+	if err := checkDueDate(dueDate); err != nil {
+		return time.Time{}, err
+	}
+	return dueDate.UTC(), nil
+}
+
+// checkDueDate returns an error when the due date is after the latest date that can be stored
+func checkDueDate(dueDate time.Time) error {
+	if dueDate.After(maxDueDate) {
+		return fmt.Errorf("due date must be up to %s", maxDueDate.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// offsetDuration returns the duration of the given hours, minutes, seconds and milliseconds
+func offsetDuration(hours, minutes, seconds, milliseconds int) (time.Duration, error) {
+	var d time.Duration
+	var err error
+	for _, part := range []struct {
+		v    int
+		unit time.Duration
+	}{{hours, time.Hour}, {minutes, time.Minute}, {seconds, time.Second}, {milliseconds, time.Millisecond}} {
+		if d, err = addDuration(d, int64(part.v), part.unit); err != nil {
+			return 0, err
+		}
+	}
+	return d, nil
+}
+
+// addDuration returns d plus v units, or errDurationTooLong when the sum would overflow. d and v are not negative
+func addDuration(d time.Duration, v int64, unit time.Duration) (time.Duration, error) {
+	if v > (math.MaxInt64-int64(d))/int64(unit) {
+		return 0, errDurationTooLong
+	}
+	return d + time.Duration(v)*unit, nil
+}
+
+// parseDueAt parses an RFC 3339 date. The date must have either an offset, or no offset and an IANA time zone
+func parseDueAt(dueAt, timeZone string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, dueAt); err == nil {
+		if timeZone != "" {
+			return time.Time{}, errors.New("dueAt with an offset can't be combined with timeZone")
+		}
+		return t, nil
+	}
+	if timeZone == "" {
+		return time.Time{}, errors.New("dueAt must be an RFC 3339 date with an offset, or a local date with timeZone")
+	}
+
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unknown timeZone %q", timeZone)
+	}
+	const localLayout = "2006-01-02T15:04:05.999999999"
+	wall, err := time.Parse(localLayout, dueAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("dueAt must be an RFC 3339 date, got %q", dueAt)
+	}
+
+	// a local time can be skipped or repeated when the clocks change, find all instants that have this wall clock
+	// in loc using the offsets around it
+	var matches []time.Time
+	approx := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), loc)
+	for _, around := range []time.Time{approx.Add(-12 * time.Hour), approx.Add(12 * time.Hour)} {
+		_, offset := around.Zone()
+		t := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if t.Format(localLayout) == wall.Format(localLayout) && (len(matches) == 0 || !matches[0].Equal(t)) {
+			matches = append(matches, t)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return time.Time{}, fmt.Errorf("%s doesn't exist in %s because of a clock change", dueAt, timeZone)
+	case 1:
+		return matches[0], nil
+	default:
+		return time.Time{}, fmt.Errorf("%s is ambiguous in %s because of a clock change, use an offset instead", dueAt, timeZone)
+	}
+}
+
+// parseISODuration parses an ISO-8601 duration such as PT2H30M, only weeks, days, hours, minutes and seconds are supported
+func parseISODuration(s string) (time.Duration, error) {
+	m := isoDurationRegex.FindStringSubmatch(s)
+	if m == nil || s == "P" || s[len(s)-1] == 'T' {
+		return 0, fmt.Errorf("duration must be an ISO-8601 duration of weeks, days, hours, minutes and seconds such as PT2H30M, got %q", s)
+	}
+
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute} {
+		if m[i+1] == "" {
+			continue
+		}
+		v, err := strconv.ParseInt(m[i+1], 10, 64)
+		if err != nil {
+			return 0, errDurationTooLong
+		}
+		if d, err = addDuration(d, v, unit); err != nil {
+			return 0, err
+		}
+	}
+	if m[5] != "" {
+		secs, err := strconv.ParseFloat(strings.Replace(m[5], ",", ".", 1), 64)
+		if err != nil {
+			return 0, err
+		}
+		if secs*float64(time.Second) >= float64(math.MaxInt64-d) {
+			return 0, errDurationTooLong
+		}
+		d += time.Duration(secs * float64(time.Second))
+	}
+	return d, nil
+}
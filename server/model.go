@@ -1,17 +1,100 @@
 package server
 
+import "time"
+
 type SetTimerReq struct {
-	Hours   int    `json:"hours" validate:"gte=0"`
-	Minutes int    `json:"minutes" validate:"gte=0"`
-	Seconds int    `json:"seconds" validate:"gte=0"`
-	URL     string `json:"url" validate:"empty=false&format=url"`
+	Hours        int               `json:"hours" validate:"gte=0"`
+	Minutes      int               `json:"minutes" validate:"gte=0"`
+	Seconds      int               `json:"seconds" validate:"gte=0"`
+	Milliseconds int               `json:"milliseconds" validate:"gte=0"`
+	URL          string            `json:"url" validate:"empty=false&format=url"`
+	Duration     string            `json:"duration"`  // ISO-8601 duration from now, e.g. PT2H30M
+	DueAt        string            `json:"dueAt"`     // RFC 3339 date with an offset, or without one when timeZone is given
+	TimeZone     string            `json:"timeZone"`  // IANA time zone of dueAt, e.g. Europe/Berlin
+	AllowPast    bool              `json:"allowPast"` // fire right away instead of rejecting a due date in the past
+	Cron         string            `json:"cron"`
+	Retry        *RetryReq         `json:"retry"`
+	Method       string            `json:"method" validate:"empty=true | one_of=GET,POST,PUT,PATCH,DELETE"` // POST by default
+	Headers      map[string]string `json:"headers"`
+	Body         string            `json:"body"`
+	OmitIDSuffix bool              `json:"omitIdSuffix"` // call the URL as is instead of <url>/<id>
+}
+
+// RetryReq overrides the default retry policy of a timer, omitted fields use the default
+type RetryReq struct {
+	MaxAttempts *int     `json:"maxAttempts" validate:"> gte=0"`
+	BaseDelayMs *int64   `json:"baseDelayMs" validate:"> gte=0"`
+	MaxDelayMs  *int64   `json:"maxDelayMs" validate:"> gte=0"`
+	Jitter      *float64 `json:"jitter" validate:"> gte=0 & lte=1"`
+}
+
+// UpdateTimerReq edits a pending timer, only the given fields are changed.
+// When any of hours, minutes, seconds or milliseconds is given the timer is rescheduled relative to now
+type UpdateTimerReq struct {
+	Hours        *int    `json:"hours" validate:"> gte=0"`
+	Minutes      *int    `json:"minutes" validate:"> gte=0"`
+	Seconds      *int    `json:"seconds" validate:"> gte=0"`
+	Milliseconds *int    `json:"milliseconds" validate:"> gte=0"`
+	URL          *string `json:"url" validate:"> format=url"`
 }
 
 type SetTimerResp struct {
 	ID int `json:"id"`
 }
 
+type TimerResp struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Status    string    `json:"status"`
+	DueDate   time.Time `json:"due_date"`
+	Cron      string    `json:"cron,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ListTimersResp struct {
+	Timers     []TimerResp `json:"timers"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+}
+
+type TimerRunResp struct {
+	Event      string    `json:"event"` // run or reclaim
+	RunAt      time.Time `json:"run_at"`
+	Error      string    `json:"error,omitempty"`
+	Attempt    int       `json:"attempt"`
+	HTTPStatus int       `json:"http_status,omitempty"`
+	LatencyMs  int64     `json:"latency_ms"`
+}
+
+type TimerHistoryResp struct {
+	ID      int            `json:"id"`
+	History []TimerRunResp `json:"history"`
+}
+
+type DeadLetterResp struct {
+	ID     string    `json:"id"`
+	Body   string    `json:"body"`
+	Reason string    `json:"reason,omitempty"`
+	Count  int       `json:"count"`
+	DeadAt time.Time `json:"dead_at"`
+}
+
+type ListDeadLettersResp struct {
+	DeadLetters []DeadLetterResp `json:"dead_letters"`
+}
+
+type PurgeDeadLettersResp struct {
+	Purged int `json:"purged"`
+}
+
+type HealthResp struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"` // ok or the error of every check
+}
+
 type GetTimerResp struct {
-	ID       int   `json:"id"`
-	TimeLeft int64 `json:"time_left"`
+	ID         int    `json:"id"`
+	TimeLeft   int64  `json:"time_left"` // in seconds
+	TimeLeftMs int64  `json:"time_left_ms"`
+	Cron       string `json:"cron,omitempty"`
 }
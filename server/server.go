@@ -1,31 +1,73 @@
 package server
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/Av1shay/timers-scheduler-demo/logx"
 	"github.com/Av1shay/timers-scheduler-demo/task"
 	"github.com/gorilla/mux"
 	"gopkg.in/dealancer/validate.v2"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
+	"unicode"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+
+	idempotencyKeyHeader = "Idempotency-Key"
+	clientIdHeader       = "X-Client-Id" // the caller that idempotency keys are scoped to
+	maxIdempotencyKeyLen = 255
 )
 
 type Server struct {
 	taskService *task.Service
+	deadLetters task.DeadLetterQueue
+	// healthChecks are reported by /healthz by their name
+	healthChecks map[string]HealthChecker
 }
 
-func New(taskService *task.Service) *Server {
-	s := &Server{taskService}
+// HealthChecker returns an error when a dependency of the service is unhealthy
+type HealthChecker interface {
+	Healthy() error
+}
+
+// New creates the server, admin routes of dead letters are mounted only when deadLetters is not nil
+func New(taskService *task.Service, deadLetters task.DeadLetterQueue) *Server {
+	s := &Server{taskService, deadLetters, make(map[string]HealthChecker)}
 	return s
 }
 
+// AddHealthCheck adds a dependency to /healthz, it must be called before the server is started
+func (s *Server) AddHealthCheck(name string, c HealthChecker) {
+	s.healthChecks[name] = c
+}
+
 func (s *Server) MountHandlers(router *mux.Router) {
 	router.Use(traceIdMiddleware)
 	router.Use(logMiddleware)
 	router.HandleFunc("/timers", s.NewTimer).Methods(http.MethodPost)
+	router.HandleFunc("/timers", s.ListTimers).Methods(http.MethodGet)
 	router.HandleFunc("/timers/{id}", s.GetTimer).Methods(http.MethodGet)
+	router.HandleFunc("/timers/{id}", s.UpdateTimer).Methods(http.MethodPatch)
+	router.HandleFunc("/timers/{id}", s.CancelTimer).Methods(http.MethodDelete)
+	router.HandleFunc("/timers/{id}/history", s.GetTimerHistory).Methods(http.MethodGet)
 	router.HandleFunc("/test-webhook/{id}", s.Test).Methods(http.MethodPost) // for testing purposes
+	router.HandleFunc("/healthz", s.Healthz).Methods(http.MethodGet)
+
+	if s.deadLetters != nil {
+		router.HandleFunc("/admin/dead-letters", s.ListDeadLetters).Methods(http.MethodGet)
+		router.HandleFunc("/admin/dead-letters", s.PurgeDeadLetters).Methods(http.MethodDelete)
+		router.HandleFunc("/admin/dead-letters/{id}", s.GetDeadLetter).Methods(http.MethodGet)
+		router.HandleFunc("/admin/dead-letters/{id}/replay", s.ReplayDeadLetter).Methods(http.MethodPost)
+	}
 }
 
 func (s *Server) NewTimer(w http.ResponseWriter, r *http.Request) {
@@ -38,14 +80,74 @@ func (s *Server) NewTimer(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	reqBody.Method = strings.ToUpper(reqBody.Method)
 	if err := validate.Validate(reqBody); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	for name, value := range reqBody.Headers {
+		if !isHeaderName(name) {
+			http.Error(w, fmt.Sprintf("invalid header name %q", name), http.StatusBadRequest)
+			return
+		}
+		if !isHeaderValue(value) {
+			http.Error(w, fmt.Sprintf("invalid value of header %s", name), http.StatusBadRequest)
+			return
+		}
+	}
+
+	idempotencyKey := r.Header.Get(idempotencyKeyHeader)
+	if len(idempotencyKey) > maxIdempotencyKeyLen {
+		http.Error(w, fmt.Sprintf("%s must be up to %d characters", idempotencyKeyHeader, maxIdempotencyKeyLen), http.StatusBadRequest)
+		return
+	}
+	var caller, hash string
+	if idempotencyKey != "" {
+		caller, hash = r.Header.Get(clientIdHeader), requestHash(reqBody)
+		// a retried request gets the timer it created, even if its due date has passed since
+		existing, err := s.taskService.GetIdempotentTask(ctx, &task.Task{Caller: caller, IdempotencyKey: idempotencyKey, RequestHash: hash})
+		if err != nil {
+			logx.Error(ctx, "failed to get idempotent task:", err)
+			msg, code := parseError(err)
+			http.Error(w, msg, code)
+			return
+		}
+		if existing != nil {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(SetTimerResp{ID: existing.ID})
+			return
+		}
+	}
 
-	n := time.Now().UTC()
-	dueDate := n.Add(time.Hour*time.Duration(reqBody.Hours) + time.Minute*time.Duration(reqBody.Minutes) + time.Second*time.Duration(reqBody.Seconds))
-	createdTask, err := s.taskService.SaveTask(ctx, dueDate, reqBody.URL)
+	dueDate, err := resolveDueDate(&reqBody, time.Now().UTC())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	t := &task.Task{
+		WebhookURL:   reqBody.URL,
+		Method:       reqBody.Method,
+		Headers:      reqBody.Headers,
+		Body:         reqBody.Body,
+		OmitIDSuffix: reqBody.OmitIDSuffix,
+		DueDate:      dueDate,
+		Cron:         reqBody.Cron,
+	}
+	if idempotencyKey != "" {
+		t.IdempotencyKey = idempotencyKey
+		t.Caller = caller
+		t.RequestHash = hash
+	}
+	if reqBody.Retry != nil {
+		t.Retry = &task.RetryOverride{
+			MaxAttempts: reqBody.Retry.MaxAttempts,
+			BaseDelay:   millisecondsDuration(reqBody.Retry.BaseDelayMs),
+			MaxDelay:    millisecondsDuration(reqBody.Retry.MaxDelayMs),
+			Jitter:      reqBody.Retry.Jitter,
+		}
+	}
+	createdTask, err := s.taskService.SaveTask(ctx, t)
 	if err != nil {
 		logx.Error(ctx, "failed to save task:", err)
 		msg, code := parseError(err)
@@ -57,6 +159,78 @@ func (s *Server) NewTimer(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(SetTimerResp{ID: createdTask.ID})
 }
 
+// ListTimers returns timers filtered by the query params, ordered by id and paginated with the returned next_cursor
+func (s *Server) ListTimers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	filter := &task.TaskFilter{
+		WebhookHost: query.Get("host"),
+		Limit:       defaultListLimit,
+	}
+	for _, status := range query["status"] {
+		filter.Statuses = append(filter.Statuses, strings.Split(status, ",")...)
+	}
+	for param, dst := range map[string]**time.Time{
+		"due_from":     &filter.DueFrom,
+		"due_to":       &filter.DueTo,
+		"created_from": &filter.CreatedFrom,
+		"created_to":   &filter.CreatedTo,
+	} {
+		if v := query.Get(param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("%s must be an RFC 3339 date", param), http.StatusBadRequest)
+				return
+			}
+			*dst = &t
+		}
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxListLimit {
+			http.Error(w, fmt.Sprintf("limit must be a number between 1 and %d", maxListLimit), http.StatusBadRequest)
+			return
+		}
+		filter.Limit = limit
+	}
+	if v := query.Get("cursor"); v != "" {
+		afterID, err := decodeCursor(v)
+		if err != nil {
+			http.Error(w, "invalid cursor", http.StatusBadRequest)
+			return
+		}
+		filter.AfterID = afterID
+	}
+
+	tasks, hasMore, err := s.taskService.ListTasks(ctx, filter)
+	if err != nil {
+		logx.Error(ctx, "failed to list tasks:", err)
+		msg, code := parseError(err)
+		http.Error(w, msg, code)
+		return
+	}
+
+	resp := ListTimersResp{Timers: make([]TimerResp, len(tasks))}
+	for i, t := range tasks {
+		resp.Timers[i] = TimerResp{
+			ID:        t.ID,
+			URL:       t.WebhookURL,
+			Status:    t.Status,
+			DueDate:   t.DueDate,
+			Cron:      t.Cron,
+			CreatedAt: t.CreatedAt,
+			UpdatedAt: t.UpdatedAt,
+		}
+	}
+	if hasMore {
+		resp.NextCursor = encodeCursor(tasks[len(tasks)-1].ID)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
 func (s *Server) GetTimer(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -77,15 +251,244 @@ func (s *Server) GetTimer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	n := time.Now().UTC().Truncate(time.Second)
-	timeLeft := t.DueDate.Sub(n)
-	secs := int64(timeLeft.Seconds())
-	if secs < 0 {
-		secs = 0
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", taskETag(t))
+	json.NewEncoder(w).Encode(newGetTimerResp(t))
+}
+
+func (s *Server) GetTimerHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	params := mux.Vars(r)
+	idParam := params["id"]
+
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		http.Error(w, "id must be be a numeric number", http.StatusBadRequest)
+		return
+	}
+
+	runs, err := s.taskService.GetTaskHistory(ctx, id)
+	if err != nil {
+		logx.Error(ctx, "failed to get task history:", err)
+		msg, code := parseError(err)
+		http.Error(w, msg, code)
+		return
+	}
+
+	resp := TimerHistoryResp{ID: id, History: make([]TimerRunResp, len(runs))}
+	for i, run := range runs {
+		resp.History[i] = TimerRunResp{
+			Event:      run.Event,
+			RunAt:      run.RunAt,
+			Error:      run.Error,
+			Attempt:    run.Attempt,
+			HTTPStatus: run.HTTPStatus,
+			LatencyMs:  run.Latency.Milliseconds(),
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// UpdateTimer edits a pending timer, send the ETag of GetTimer in If-Match header to avoid overriding concurrent changes
+func (s *Server) UpdateTimer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	params := mux.Vars(r)
+	idParam := params["id"]
+
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		http.Error(w, "id must be be a numeric number", http.StatusBadRequest)
+		return
+	}
+	version, err := parseIfMatch(r.Header.Get("If-Match"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	defer r.Body.Close()
+	var reqBody UpdateTimerReq
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		logx.Error(ctx, "failed to parse request body:", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := validate.Validate(reqBody); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	changes := &task.TaskChanges{WebhookURL: reqBody.URL}
+	if reqBody.Hours != nil || reqBody.Minutes != nil || reqBody.Seconds != nil || reqBody.Milliseconds != nil {
+		offset, err := offsetDuration(valueOrZero(reqBody.Hours), valueOrZero(reqBody.Minutes),
+			valueOrZero(reqBody.Seconds), valueOrZero(reqBody.Milliseconds))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		dueDate := time.Now().UTC().Add(offset)
+		if err := checkDueDate(dueDate); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		changes.DueDate = &dueDate
+	}
+	if changes.DueDate == nil && changes.WebhookURL == nil {
+		http.Error(w, "nothing to update", http.StatusBadRequest)
+		return
+	}
+
+	t, err := s.taskService.UpdateTask(ctx, id, changes, version)
+	if err != nil {
+		logx.Error(ctx, "failed to update task:", err)
+		msg, code := parseError(err)
+		http.Error(w, msg, code)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", taskETag(t))
+	json.NewEncoder(w).Encode(newGetTimerResp(t))
+}
+
+func (s *Server) CancelTimer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	params := mux.Vars(r)
+	idParam := params["id"]
+
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		http.Error(w, "id must be be a numeric number", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.taskService.CancelTask(ctx, id); err != nil {
+		logx.Error(ctx, "failed to cancel task:", err)
+		msg, code := parseError(err)
+		http.Error(w, msg, code)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListDeadLetters returns messages from the head of the dead letter queue
+func (s *Server) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := defaultListLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		var err error
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxListLimit {
+			http.Error(w, fmt.Sprintf("limit must be a number between 1 and %d", maxListLimit), http.StatusBadRequest)
+			return
+		}
+	}
+
+	deadLetters, err := s.deadLetters.ListDeadLetters(ctx, limit)
+	if err != nil {
+		logx.Error(ctx, "failed to list dead letters:", err)
+		msg, code := parseError(err)
+		http.Error(w, msg, code)
+		return
+	}
+
+	resp := ListDeadLettersResp{DeadLetters: make([]DeadLetterResp, len(deadLetters))}
+	for i, d := range deadLetters {
+		resp.DeadLetters[i] = newDeadLetterResp(d)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(GetTimerResp{ID: t.ID, TimeLeft: secs})
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) GetDeadLetter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	params := mux.Vars(r)
+	deadLetter, err := s.deadLetters.GetDeadLetter(ctx, params["id"])
+	if err != nil {
+		logx.Error(ctx, "failed to get dead letter:", err)
+		msg, code := parseError(err)
+		http.Error(w, msg, code)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(newDeadLetterResp(deadLetter))
+}
+
+// ReplayDeadLetter publishes the dead letter back to the tasks queue. A failed task of the dead letter is revived first,
+// otherwise the replayed message is dropped since the task is no longer running
+func (s *Server) ReplayDeadLetter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	params := mux.Vars(r)
+	deadLetter, err := s.deadLetters.GetDeadLetter(ctx, params["id"])
+	if err != nil {
+		logx.Error(ctx, "failed to get dead letter:", err)
+		msg, code := parseError(err)
+		http.Error(w, msg, code)
+		return
+	}
+	var t task.Task
+	if err := json.Unmarshal([]byte(deadLetter.Body), &t); err == nil && t.ID != 0 {
+		if err := s.taskService.ReviveTask(ctx, t.ID); err != nil {
+			logx.Error(ctx, "failed to revive task of dead letter:", err)
+			msg, code := parseError(err)
+			http.Error(w, msg, code)
+			return
+		}
+	}
+	if err := s.deadLetters.ReplayDeadLetter(ctx, params["id"]); err != nil {
+		logx.Error(ctx, "failed to replay dead letter:", err)
+		msg, code := parseError(err)
+		http.Error(w, msg, code)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) PurgeDeadLetters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	purged, err := s.deadLetters.PurgeDeadLetters(ctx)
+	if err != nil {
+		logx.Error(ctx, "failed to purge dead letters:", err)
+		msg, code := parseError(err)
+		http.Error(w, msg, code)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(PurgeDeadLettersResp{Purged: purged})
+}
+
+// Healthz responds with 503 when any of the health checks fails, so the instance can be restarted or taken out of
+// rotation while it can't fire timers
+func (s *Server) Healthz(w http.ResponseWriter, r *http.Request) {
+	resp := HealthResp{Status: "ok", Checks: make(map[string]string, len(s.healthChecks))}
+	code := http.StatusOK
+	for name, c := range s.healthChecks {
+		if err := c.Healthy(); err != nil {
+			resp.Checks[name] = err.Error()
+			resp.Status = "unhealthy"
+			code = http.StatusServiceUnavailable
+			continue
+		}
+		resp.Checks[name] = "ok"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(resp)
 }
 
 // Test dummy route just to check webhooks
@@ -96,6 +499,108 @@ func (s *Server) Test(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func newDeadLetterResp(d *task.DeadLetter) DeadLetterResp {
+	return DeadLetterResp{
+		ID:     d.ID,
+		Body:   d.Body,
+		Reason: d.Reason,
+		Count:  d.Count,
+		DeadAt: d.DeadAt,
+	}
+}
+
+func newGetTimerResp(t *task.Task) GetTimerResp {
+	timeLeft := t.DueDate.Sub(time.Now().UTC())
+	if timeLeft < 0 {
+		timeLeft = 0
+	}
+	return GetTimerResp{
+		ID:         t.ID,
+		TimeLeft:   int64(timeLeft.Round(time.Second).Seconds()),
+		TimeLeftMs: timeLeft.Milliseconds(),
+		Cron:       t.Cron,
+	}
+}
+
+// isHeaderName reports whether name is a valid HTTP header name (a RFC 7230 token)
+func isHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		if c > unicode.MaxASCII || !(unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune("!#$%&'*+-.^_`|~", c)) {
+			return false
+		}
+	}
+	return true
+}
+
+func valueOrZero(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// isHeaderValue reports whether value is a valid HTTP header value, it can't have control characters other than tab
+// (RFC 7230), so a value with CR or LF is rejected instead of failing every webhook call
+func isHeaderValue(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if c := value[i]; (c < ' ' && c != '\t') || c == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// millisecondsDuration returns the duration of ms milliseconds, nil when ms is not set
+func millisecondsDuration(ms *int64) *time.Duration {
+	if ms == nil {
+		return nil
+	}
+	d := time.Duration(*ms) * time.Millisecond
+	return &d
+}
+
+// requestHash returns the hash of the decoded request, so requests that differ only in formatting have the same hash
+func requestHash(req SetTimerReq) string {
+	b, _ := json.Marshal(req)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// encodeCursor returns an opaque pagination cursor pointing after the given task id
+func encodeCursor(id int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(id)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(b))
+}
+
+// taskETag formats the task version (its updated_at in microseconds) as an ETag
+func taskETag(t *task.Task) string {
+	return strconv.Quote(strconv.FormatInt(t.UpdatedAt.UnixMicro(), 10))
+}
+
+// parseIfMatch parses an If-Match header created by taskETag, returns nil when there is nothing to match
+func parseIfMatch(header string) (*time.Time, error) {
+	header = strings.TrimPrefix(strings.TrimSpace(header), "W/")
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+	micros, err := strconv.ParseInt(strings.Trim(header, `"`), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid If-Match header %s", header)
+	}
+	version := time.UnixMicro(micros).UTC()
+	return &version, nil
+}
+
 func parseError(err error) (string, int) {
 	msg := err.Error()
 	code := http.StatusInternalServerError
@@ -103,6 +608,9 @@ func parseError(err error) (string, int) {
 		msg = e.ClientMessage
 		code = e.Code
 	}
+	if errors.Is(err, task.ErrDeadLetterNotFound) {
+		code = http.StatusNotFound
+	}
 	return msg, code
 }
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/Av1shay/timers-scheduler-demo/ent"
 	task2 "github.com/Av1shay/timers-scheduler-demo/ent/task"
@@ -12,6 +13,7 @@ import (
 	"github.com/gorilla/mux"
 	"io"
 	"log"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -20,10 +22,55 @@ import (
 )
 
 var (
-	dbClient *ent.Client
-	ts       *httptest.Server
+	dbClient    *ent.Client
+	deadLetters *mockDeadLetterQueue
+	health      *mockHealthChecker
+	ts          *httptest.Server
 )
 
+type mockHealthChecker struct {
+	err error
+}
+
+func (c *mockHealthChecker) Healthy() error {
+	return c.err
+}
+
+type mockDeadLetterQueue struct {
+	deadLetters []*task.DeadLetter
+	replayed    []string
+}
+
+func (q *mockDeadLetterQueue) ListDeadLetters(_ context.Context, limit int) ([]*task.DeadLetter, error) {
+	if limit > len(q.deadLetters) {
+		limit = len(q.deadLetters)
+	}
+	return q.deadLetters[:limit], nil
+}
+
+func (q *mockDeadLetterQueue) GetDeadLetter(_ context.Context, id string) (*task.DeadLetter, error) {
+	for _, d := range q.deadLetters {
+		if d.ID == id {
+			return d, nil
+		}
+	}
+	return nil, task.ErrDeadLetterNotFound
+}
+
+func (q *mockDeadLetterQueue) ReplayDeadLetter(ctx context.Context, id string) error {
+	if _, err := q.GetDeadLetter(ctx, id); err != nil {
+		return err
+	}
+	q.replayed = append(q.replayed, id)
+	return nil
+}
+
+func (q *mockDeadLetterQueue) PurgeDeadLetters(_ context.Context) (int, error) {
+	n := len(q.deadLetters)
+	q.deadLetters = nil
+	return n, nil
+}
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -38,7 +85,10 @@ func TestMain(m *testing.M) {
 		log.Fatal(err)
 	}
 	taskService := task.NewService(dbClient, nil, nil)
-	srv := New(taskService)
+	deadLetters = &mockDeadLetterQueue{}
+	srv := New(taskService, deadLetters)
+	health = &mockHealthChecker{}
+	srv.AddHealthCheck("queue", health)
 
 	router := mux.NewRouter()
 	srv.MountHandlers(router)
@@ -170,3 +220,631 @@ func TestServer_GetTimer(t *testing.T) {
 		t.Errorf("expxected timeLeft to be 0, got %d", respData.TimeLeft)
 	}
 }
+
+func TestServer_NewCronTimer(t *testing.T) {
+	ctx := context.Background()
+	dummyRequest := SetTimerReq{
+		URL:  "https://example.com",
+		Cron: "*/5 * * * *",
+	}
+	b, _ := json.Marshal(dummyRequest)
+	res, err := http.Post(ts.URL+"/timers", "application/json", bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != 200 {
+		t.Fatalf("status code %d", res.StatusCode)
+	}
+	var respData SetTimerResp
+	err = json.NewDecoder(res.Body).Decode(&respData)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taskEnt, err := dbClient.Task.Get(ctx, respData.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if taskEnt.Cron != dummyRequest.Cron {
+		t.Errorf("want task cron to be %s, got %s", dummyRequest.Cron, taskEnt.Cron)
+	}
+	n := time.Now()
+	if !taskEnt.DueDate.After(n) || taskEnt.DueDate.After(n.Add(5*time.Minute)) {
+		t.Errorf("expected due date to be in the next 5 minutes, got %s", taskEnt.DueDate)
+	}
+
+	// check validation
+	for _, req := range []SetTimerReq{
+		{URL: "https://example.com", Cron: "not a cron"},
+		{URL: "https://example.com", Cron: "0 0 30 2 *"},
+		{URL: "https://example.com", Cron: "*/5 * * * *", Minutes: 2},
+	} {
+		b, _ = json.Marshal(req)
+		res, err = http.Post(ts.URL+"/timers", "application/json", bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.StatusCode != 400 {
+			t.Errorf("expxected status code 400, got %d", res.StatusCode)
+		}
+	}
+}
+
+func TestServer_CancelTimer(t *testing.T) {
+	ctx := context.Background()
+
+	taskEnt, err := dbClient.Task.Create().SetDueDate(time.Now().Add(30 * time.Second)).SetWebhookUrl("https://example.com").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cancelTimer := func(id int) int {
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/timers/%d", ts.URL, id), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	if code := cancelTimer(taskEnt.ID); code != http.StatusNoContent {
+		t.Fatalf("status code %d", code)
+	}
+	taskEnt, err = dbClient.Task.Get(ctx, taskEnt.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if taskEnt.Status != task2.StatusCancelled {
+		t.Errorf("want task status to be %s, got %s", task2.StatusCancelled, taskEnt.Status)
+	}
+
+	if code := cancelTimer(taskEnt.ID); code != http.StatusConflict {
+		t.Errorf("expxected status code 409, got %d", code)
+	}
+	if code := cancelTimer(taskEnt.ID + 1000000); code != http.StatusNotFound {
+		t.Errorf("expxected status code 404, got %d", code)
+	}
+}
+
+func TestServer_UpdateTimer(t *testing.T) {
+	ctx := context.Background()
+
+	taskEnt, err := dbClient.Task.Create().SetDueDate(time.Now().Add(30 * time.Second)).SetWebhookUrl("https://example.com").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := http.Get(fmt.Sprintf("%s/timers/%d", ts.URL, taskEnt.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	etag := res.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("expected GET response to have an ETag")
+	}
+
+	updateTimer := func(body, ifMatch string) *http.Response {
+		req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/timers/%d", ts.URL, taskEnt.ID), bytes.NewReader([]byte(body)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("If-Match", ifMatch)
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res
+	}
+
+	res = updateTimer(`{"minutes":10,"url":"https://example.org"}`, etag)
+	if res.StatusCode != 200 {
+		t.Fatalf("status code %d", res.StatusCode)
+	}
+	if newEtag := res.Header.Get("ETag"); newEtag == "" || newEtag == etag {
+		t.Errorf("expected a new ETag, got %s", newEtag)
+	}
+	updatedTask, err := dbClient.Task.Get(ctx, taskEnt.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updatedTask.WebhookUrl != "https://example.org" {
+		t.Errorf("want task URL to be https://example.org, got %s", updatedTask.WebhookUrl)
+	}
+	if updatedTask.DueDate.Before(time.Now().Add(9 * time.Minute)) {
+		t.Errorf("expected task to be rescheduled in 10 minutes, got %s", updatedTask.DueDate)
+	}
+
+	// stale version
+	if res = updateTimer(`{"seconds":5}`, etag); res.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("expxected status code 412, got %d", res.StatusCode)
+	}
+
+	// running task cannot be edited
+	if _, err := dbClient.Task.UpdateOneID(taskEnt.ID).SetStatus(task2.StatusRunning).Save(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if res = updateTimer(`{"seconds":5}`, ""); res.StatusCode != http.StatusConflict {
+		t.Errorf("expxected status code 409, got %d", res.StatusCode)
+	}
+}
+
+func TestServer_ListTimers(t *testing.T) {
+	ctx := context.Background()
+
+	host := fmt.Sprintf("list-%d.example.com", time.Now().UnixNano())
+	n := time.Now().UTC()
+	ids := make([]int, 3)
+	for i := range ids {
+		taskEnt, err := dbClient.Task.Create().
+			SetDueDate(n.Add(time.Duration(i) * time.Hour)).
+			SetWebhookUrl("https://" + host).
+			SetWebhookHost(host).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = taskEnt.ID
+	}
+	if _, err := dbClient.Task.UpdateOneID(ids[2]).SetStatus(task2.StatusCancelled).Save(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	listTimers := func(query string) ListTimersResp {
+		res, err := http.Get(ts.URL + "/timers?" + query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode != 200 {
+			t.Fatalf("status code %d", res.StatusCode)
+		}
+		var respData ListTimersResp
+		if err := json.NewDecoder(res.Body).Decode(&respData); err != nil {
+			t.Fatal(err)
+		}
+		return respData
+	}
+
+	// pagination
+	page := listTimers("host=" + host + "&limit=2")
+	if len(page.Timers) != 2 || page.Timers[0].ID != ids[0] || page.Timers[1].ID != ids[1] {
+		t.Fatalf("expected first page to have tasks %v, got %v", ids[:2], page.Timers)
+	}
+	if page.NextCursor == "" {
+		t.Fatal("expected first page to have next cursor")
+	}
+	page = listTimers("host=" + host + "&limit=2&cursor=" + page.NextCursor)
+	if len(page.Timers) != 1 || page.Timers[0].ID != ids[2] {
+		t.Fatalf("expected second page to have task %d, got %v", ids[2], page.Timers)
+	}
+	if page.NextCursor != "" {
+		t.Errorf("expected last page to have no cursor, got %s", page.NextCursor)
+	}
+
+	// filters
+	page = listTimers("host=" + host + "&status=cancelled")
+	if len(page.Timers) != 1 || page.Timers[0].ID != ids[2] {
+		t.Errorf("expected cancelled task %d, got %v", ids[2], page.Timers)
+	}
+	page = listTimers("host=" + host + "&due_to=" + n.Add(30*time.Minute).Format(time.RFC3339))
+	if len(page.Timers) != 1 || page.Timers[0].ID != ids[0] {
+		t.Errorf("expected task %d, got %v", ids[0], page.Timers)
+	}
+
+	// check validation
+	for _, query := range []string{"status=unknown", "due_from=yesterday", "limit=0", "cursor=%%%"} {
+		res, err := http.Get(ts.URL + "/timers?" + query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != 400 {
+			t.Errorf("expxected status code 400 for %s, got %d", query, res.StatusCode)
+		}
+	}
+}
+
+func TestServer_GetTimerHistory(t *testing.T) {
+	ctx := context.Background()
+
+	taskEnt, err := dbClient.Task.Create().SetDueDate(time.Now()).SetWebhookUrl("https://example.com").SetStatus(task2.StatusDone).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := time.Now().UTC().Truncate(time.Second)
+	_, err = dbClient.TaskHistory.Create().SetTask(taskEnt).SetRunAt(n.Add(-time.Minute)).SetError("status code: 500").SetHttpStatus(500).SetLatencyMs(120).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = dbClient.TaskHistory.Create().SetTask(taskEnt).SetRunAt(n).SetAttempt(2).SetHttpStatus(200).SetLatencyMs(80).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := http.Get(fmt.Sprintf("%s/timers/%d/history", ts.URL, taskEnt.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != 200 {
+		t.Fatalf("status code %d", res.StatusCode)
+	}
+	var respData TimerHistoryResp
+	err = json.NewDecoder(res.Body).Decode(&respData)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(respData.History) != 2 {
+		t.Fatalf("expected 2 history entries, got %d", len(respData.History))
+	}
+	first, second := respData.History[0], respData.History[1]
+	if first.Attempt != 1 || first.HTTPStatus != 500 || first.Error == "" || first.LatencyMs != 120 {
+		t.Errorf("unexpected first run %+v", first)
+	}
+	if second.Attempt != 2 || second.HTTPStatus != 200 || second.Error != "" || !second.RunAt.Equal(n) {
+		t.Errorf("unexpected second run %+v", second)
+	}
+
+	res, err = http.Get(fmt.Sprintf("%s/timers/%d/history", ts.URL, taskEnt.ID+1000000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("expxected status code 404, got %d", res.StatusCode)
+	}
+}
+
+func TestServer_DeadLetters(t *testing.T) {
+	deadLetters.deadLetters = []*task.DeadLetter{
+		{ID: "msg-1", Body: "not a task", Reason: "rejected", Count: 1, DeadAt: time.Now().UTC()},
+		{ID: "msg-2", Body: `{"id":5}`, Reason: "rejected", Count: 2, DeadAt: time.Now().UTC()},
+	}
+
+	res, err := http.Get(ts.URL + "/admin/dead-letters?limit=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var listResp ListDeadLettersResp
+	err = json.NewDecoder(res.Body).Decode(&listResp)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listResp.DeadLetters) != 1 || listResp.DeadLetters[0].ID != "msg-1" {
+		t.Errorf("expected to list msg-1, got %v", listResp.DeadLetters)
+	}
+
+	res, err = http.Get(ts.URL + "/admin/dead-letters/msg-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var getResp DeadLetterResp
+	err = json.NewDecoder(res.Body).Decode(&getResp)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if getResp.Body != `{"id":5}` || getResp.Count != 2 {
+		t.Errorf("unexpected dead letter %+v", getResp)
+	}
+
+	res, err = http.Post(ts.URL+"/admin/dead-letters/msg-2/replay", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("expxected status code 204, got %d", res.StatusCode)
+	}
+	if len(deadLetters.replayed) != 1 || deadLetters.replayed[0] != "msg-2" {
+		t.Errorf("expected msg-2 to be replayed, got %v", deadLetters.replayed)
+	}
+	res, err = http.Post(ts.URL+"/admin/dead-letters/unknown/replay", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("expxected status code 404, got %d", res.StatusCode)
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, ts.URL+"/admin/dead-letters", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var purgeResp PurgeDeadLettersResp
+	err = json.NewDecoder(res.Body).Decode(&purgeResp)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purgeResp.Purged != 2 {
+		t.Errorf("expected 2 purged dead letters, got %d", purgeResp.Purged)
+	}
+}
+
+func TestServer_NewTimerRequestOptions(t *testing.T) {
+	ctx := context.Background()
+	dummyRequest := SetTimerReq{
+		Minutes:      1,
+		URL:          "https://example.com/hooks",
+		Method:       "put",
+		Headers:      map[string]string{"X-Api-Key": "secret"},
+		Body:         `{"hello":"world"}`,
+		OmitIDSuffix: true,
+	}
+	b, _ := json.Marshal(dummyRequest)
+	res, err := http.Post(ts.URL+"/timers", "application/json", bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != 200 {
+		t.Fatalf("status code %d", res.StatusCode)
+	}
+	var respData SetTimerResp
+	err = json.NewDecoder(res.Body).Decode(&respData)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taskEnt, err := dbClient.Task.Get(ctx, respData.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if taskEnt.Method != http.MethodPut || taskEnt.Headers["X-Api-Key"] != "secret" || taskEnt.Body != dummyRequest.Body || !taskEnt.OmitIdSuffix {
+		t.Errorf("unexpected task request options %+v", taskEnt)
+	}
+
+	// check validation
+	for _, req := range []SetTimerReq{
+		{URL: "https://example.com", Method: "CONNECT"},
+		{URL: "https://example.com", Headers: map[string]string{"Bad Header": "x"}},
+		{URL: "https://example.com", Headers: map[string]string{"X-Api-Key": "secret\r\nX-Injected: 1"}},
+	} {
+		b, _ = json.Marshal(req)
+		res, err = http.Post(ts.URL+"/timers", "application/json", bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.StatusCode != 400 {
+			t.Errorf("expxected status code 400, got %d", res.StatusCode)
+		}
+	}
+}
+
+func TestServer_NewTimerDueDate(t *testing.T) {
+	ctx := context.Background()
+	dueAt := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		req  SetTimerReq
+		want time.Time
+	}{
+		{"duration", SetTimerReq{URL: "https://example.com", Duration: "PT2H30M"}, time.Now().Add(150 * time.Minute)},
+		{"due at with offset", SetTimerReq{URL: "https://example.com", DueAt: dueAt.In(berlin).Format(time.RFC3339)}, dueAt},
+		{"due at with time zone", SetTimerReq{URL: "https://example.com", DueAt: dueAt.In(berlin).Format("2006-01-02T15:04:05"), TimeZone: "Europe/Berlin"}, dueAt},
+		{"allow past", SetTimerReq{URL: "https://example.com", DueAt: "2020-01-01T00:00:00Z", AllowPast: true}, time.Now()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := json.Marshal(tt.req)
+			res, err := http.Post(ts.URL+"/timers", "application/json", bytes.NewReader(b))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.StatusCode != 200 {
+				t.Fatalf("status code %d", res.StatusCode)
+			}
+			var respData SetTimerResp
+			err = json.NewDecoder(res.Body).Decode(&respData)
+			res.Body.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			taskEnt, err := dbClient.Task.Get(ctx, respData.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := taskEnt.DueDate.Sub(tt.want); diff < -2*time.Second || diff > 2*time.Second {
+				t.Errorf("want due date %s, got %s", tt.want.UTC(), taskEnt.DueDate.UTC())
+			}
+		})
+	}
+
+	// check validation
+	for _, req := range []SetTimerReq{
+		{URL: "https://example.com", Duration: "P1M"},
+		{URL: "https://example.com", Duration: "PT1H", Minutes: 1},
+		{URL: "https://example.com", DueAt: "2026-11-01T09:00:00"},
+		{URL: "https://example.com", DueAt: "2026-11-01T09:00:00+01:00", TimeZone: "Europe/Berlin"},
+		{URL: "https://example.com", DueAt: dueAt.Format(time.RFC3339), Cron: "*/5 * * * *"},
+		{URL: "https://example.com", DueAt: "2020-01-01T00:00:00Z"},
+		{URL: "https://example.com", DueAt: "2030-10-27T02:30:00", TimeZone: "Europe/Berlin"},
+		{URL: "https://example.com", DueAt: "2030-11-01T09:00:00", TimeZone: "Nowhere/City"},
+		{URL: "https://example.com", Minutes: 1, TimeZone: "Europe/Berlin"},
+		{URL: "https://example.com", Duration: "P100000000W", AllowPast: true},
+		{URL: "https://example.com", Duration: "PT99999999999999999999H"},
+		{URL: "https://example.com", Hours: math.MaxInt64 / 1000},
+		{URL: "https://example.com", DueAt: "2040-01-01T00:00:00Z"},
+	} {
+		b, _ := json.Marshal(req)
+		res, err := http.Post(ts.URL+"/timers", "application/json", bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != 400 {
+			t.Errorf("expxected status code 400 for %+v, got %d", req, res.StatusCode)
+		}
+	}
+}
+
+func TestServer_NewTimerIdempotencyKey(t *testing.T) {
+	post := func(req SetTimerReq, key, clientId string) (*http.Response, SetTimerResp) {
+		b, _ := json.Marshal(req)
+		httpReq, err := http.NewRequest(http.MethodPost, ts.URL+"/timers", bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		httpReq.Header.Set("Content-Type", "application/json")
+		httpReq.Header.Set("Idempotency-Key", key)
+		httpReq.Header.Set("X-Client-Id", clientId)
+		res, err := http.DefaultClient.Do(httpReq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		var respData SetTimerResp
+		if res.StatusCode == 200 {
+			if err := json.NewDecoder(res.Body).Decode(&respData); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return res, respData
+	}
+
+	key := fmt.Sprintf("key-%d", time.Now().UnixNano()) // the db isn't cleared between runs
+	req := SetTimerReq{Minutes: 5, URL: "https://example.com"}
+	res, first := post(req, key, "client-a")
+	if res.StatusCode != 200 {
+		t.Fatalf("status code %d", res.StatusCode)
+	}
+
+	res, repeated := post(req, key, "client-a")
+	if res.StatusCode != 200 {
+		t.Fatalf("status code %d", res.StatusCode)
+	}
+	if repeated.ID != first.ID {
+		t.Errorf("expected repeated request to return timer %d, got %d", first.ID, repeated.ID)
+	}
+
+	res, otherCaller := post(req, key, "client-b")
+	if res.StatusCode != 200 {
+		t.Fatalf("status code %d", res.StatusCode)
+	}
+	if otherCaller.ID == first.ID {
+		t.Error("expected a new timer for another caller with the same key")
+	}
+
+	res, _ = post(SetTimerReq{Minutes: 10, URL: "https://example.com"}, key, "client-a")
+	if res.StatusCode != 409 {
+		t.Errorf("expxected status code 409, got %d", res.StatusCode)
+	}
+
+	count, err := dbClient.Task.Query().Where(task2.IdempotencyKey(key)).Count(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 timers to be created, got %d", count)
+	}
+
+	// a retry after the due date has passed returns the timer instead of rejecting the due date
+	req = SetTimerReq{DueAt: time.Now().Add(time.Second).Format(time.RFC3339Nano), URL: "https://example.com"}
+	res, first = post(req, key+"-due", "client-a")
+	if res.StatusCode != 200 {
+		t.Fatalf("status code %d", res.StatusCode)
+	}
+	time.Sleep(2500 * time.Millisecond)
+	res, repeated = post(req, key+"-due", "client-a")
+	if res.StatusCode != 200 {
+		t.Fatalf("expxected status code 200 for a retry after the due date, got %d", res.StatusCode)
+	}
+	if repeated.ID != first.ID {
+		t.Errorf("expected retry to return timer %d, got %d", first.ID, repeated.ID)
+	}
+}
+
+func TestServer_NewMillisecondTimer(t *testing.T) {
+	ctx := context.Background()
+
+	b, _ := json.Marshal(SetTimerReq{Seconds: 1, Milliseconds: 1500, URL: "https://example.com"})
+	n := time.Now()
+	res, err := http.Post(ts.URL+"/timers", "application/json", bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != 200 {
+		t.Fatalf("status code %d", res.StatusCode)
+	}
+	var respData SetTimerResp
+	err = json.NewDecoder(res.Body).Decode(&respData)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taskEnt, err := dbClient.Task.Get(ctx, respData.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := taskEnt.DueDate.Sub(n.Add(2500 * time.Millisecond)); diff < 0 || diff > 200*time.Millisecond {
+		t.Errorf("expected due date to be 2.5s from now in milliseconds, got %s", taskEnt.DueDate.Sub(n))
+	}
+
+	res, err = http.Get(fmt.Sprintf("%s/timers/%d", ts.URL, respData.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var getResp GetTimerResp
+	err = json.NewDecoder(res.Body).Decode(&getResp)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if getResp.TimeLeftMs <= 2000 || getResp.TimeLeftMs > 2500 {
+		t.Errorf("expxected time_left_ms to be in range (2000,2500], got %d", getResp.TimeLeftMs)
+	}
+}
+
+func TestServer_Healthz(t *testing.T) {
+	res, err := http.Get(ts.URL + "/healthz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var respData HealthResp
+	err = json.NewDecoder(res.Body).Decode(&respData)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != 200 || respData.Status != "ok" || respData.Checks["queue"] != "ok" {
+		t.Errorf("expected healthy response, got %d %+v", res.StatusCode, respData)
+	}
+
+	health.err = errors.New("rabbitmq is reconnecting")
+	defer func() { health.err = nil }()
+
+	res, err = http.Get(ts.URL + "/healthz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = json.NewDecoder(res.Body).Decode(&respData)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != 503 || respData.Status != "unhealthy" || respData.Checks["queue"] != "rabbitmq is reconnecting" {
+		t.Errorf("expected unhealthy response, got %d %+v", res.StatusCode, respData)
+	}
+}
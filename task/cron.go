@@ -0,0 +1,25 @@
+package task
+
+import (
+	"errors"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// errCronNeverRuns is returned for expressions that have no activation, such as February 30th
+var errCronNeverRuns = errors.New("cron expression never runs")
+
+// nextCronRun returns the first activation time of the cron expression after from.
+// Supports the standard 5 fields format, descriptors like @hourly and the CRON_TZ= prefix
+func nextCronRun(expr string, from time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	next := schedule.Next(from)
+	if next.IsZero() {
+		return time.Time{}, errCronNeverRuns
+	}
+	return next.UTC(), nil
+}
@@ -0,0 +1,24 @@
+package task
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextCronRun(t *testing.T) {
+	from := time.Date(2023, 5, 1, 10, 2, 10, 0, time.UTC)
+	next, err := nextCronRun("*/5 * * * *", from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2023, 5, 1, 10, 5, 0, 0, time.UTC); !next.Equal(want) {
+		t.Errorf("expected next run at %s, got %s", want, next)
+	}
+
+	if _, err := nextCronRun("0 0 30 2 *", from); err != errCronNeverRuns {
+		t.Errorf("expected %v for an expression that never runs, got %v", errCronNeverRuns, err)
+	}
+	if _, err := nextCronRun("not a cron", from); err == nil {
+		t.Error("expected error for an invalid expression")
+	}
+}
@@ -0,0 +1,113 @@
+package task
+
+import (
+	"context"
+	"github.com/Av1shay/timers-scheduler-demo/ent"
+	"github.com/Av1shay/timers-scheduler-demo/ent/schedulerinstance"
+	"github.com/Av1shay/timers-scheduler-demo/hashring"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// NumShards is the number of shards tasks are partitioned into. It must not be changed once tasks were saved,
+// since the shard of a task is stored with it
+const NumShards = 256
+
+// DefaultMembershipTTL is how long an instance is considered alive after its last heartbeat
+const DefaultMembershipTTL = 15 * time.Second
+
+// staleTTLs is after how many ttls since its last heartbeat an instance that didn't leave is deleted
+const staleTTLs = 10
+
+// ShardOwner returns the shards that the scheduler instance processes
+type ShardOwner interface {
+	Shards() []int
+}
+
+// Membership registers the instance in the scheduler instances table and assigns it the shards it owns by consistent
+// hashing of the live instances, so shards are rebalanced when instances join or leave.
+// Instances may disagree on the owners for a short time after a change, this is safe since tasks are claimed per row
+type Membership struct {
+	dbClient   *ent.Client
+	instanceID string
+	ttl        time.Duration
+
+	mu      sync.RWMutex
+	members []string
+	shards  []int
+}
+
+func NewMembership(dbClient *ent.Client, instanceID string, ttl time.Duration) *Membership {
+	return &Membership{dbClient: dbClient, instanceID: instanceID, ttl: ttl}
+}
+
+// Heartbeat marks the instance as alive, deletes instances that are long gone and recomputes its shards from the
+// live instances. It should be called more often than the ttl
+func (m *Membership) Heartbeat(ctx context.Context) error {
+	n := time.Now().UTC()
+	err := m.dbClient.SchedulerInstance.UpdateOneID(m.instanceID).SetHeartbeatAt(n).Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = m.dbClient.SchedulerInstance.Create().SetID(m.instanceID).SetHeartbeatAt(n).Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	// instances that crashed never leave, every live instance deletes them so the table doesn't grow with restarts
+	_, err = m.dbClient.SchedulerInstance.Delete().
+		Where(schedulerinstance.HeartbeatAtLT(n.Add(-staleTTLs * m.ttl))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	members, err := m.dbClient.SchedulerInstance.
+		Query().
+		Where(schedulerinstance.HeartbeatAtGTE(n.Add(-m.ttl))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	ring := hashring.New(hashring.DefaultReplicas, members...)
+	shards := make([]int, 0, NumShards/len(members)+1)
+	for shard := 0; shard < NumShards; shard++ {
+		if ring.Owner(strconv.Itoa(shard)) == m.instanceID {
+			shards = append(shards, shard)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.members = ring.Members()
+	m.shards = shards
+	return nil
+}
+
+// Leave removes the instance, so its shards are taken by the other instances without waiting for the ttl
+func (m *Membership) Leave(ctx context.Context) error {
+	err := m.dbClient.SchedulerInstance.DeleteOneID(m.instanceID).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// Shards returns the shards owned by the instance as of the last heartbeat, none before the first heartbeat
+func (m *Membership) Shards() []int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.shards
+}
+
+// Members returns the live instances as of the last heartbeat
+func (m *Membership) Members() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.members
+}
+
+// randomShard returns the shard of a new task
+func randomShard() int {
+	return rand.Intn(NumShards)
+}
@@ -0,0 +1,161 @@
+package task
+
+import (
+	"context"
+	"github.com/Av1shay/timers-scheduler-demo/ent"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	_ "github.com/go-sql-driver/mysql"
+	"testing"
+	"time"
+)
+
+func TestMembership_Heartbeat(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := NewMembership(dbClient, "instance-1", DefaultMembershipTTL)
+	second := NewMembership(dbClient, "instance-2", DefaultMembershipTTL)
+	if len(first.Shards()) != 0 {
+		t.Errorf("expected no shards before the first heartbeat, got %d", len(first.Shards()))
+	}
+	if err := first.Heartbeat(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Shards()) != NumShards {
+		t.Errorf("expected single instance to own all %d shards, got %d", NumShards, len(first.Shards()))
+	}
+
+	// the shards are split when another instance joins
+	for _, m := range []*Membership{second, first} {
+		if err := m.Heartbeat(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	owners := make(map[int]int)
+	for _, m := range []*Membership{first, second} {
+		if len(m.Members()) != 2 {
+			t.Errorf("expected 2 members, got %v", m.Members())
+		}
+		if len(m.Shards()) == 0 {
+			t.Errorf("expected %s to own some shards", m.instanceID)
+		}
+		for _, shard := range m.Shards() {
+			owners[shard]++
+		}
+	}
+	if len(owners) != NumShards {
+		t.Errorf("expected all %d shards to be owned, got %d", NumShards, len(owners))
+	}
+	for shard, count := range owners {
+		if count != 1 {
+			t.Errorf("expected shard %d to have a single owner, got %d", shard, count)
+		}
+	}
+
+	// and taken back when it leaves, or when its heartbeat expires
+	if err := second.Leave(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := first.Heartbeat(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Shards()) != NumShards {
+		t.Errorf("expected %d shards after the other instance left, got %d", NumShards, len(first.Shards()))
+	}
+	_, err = dbClient.SchedulerInstance.Create().SetID("instance-3").SetHeartbeatAt(time.Now().Add(-time.Minute)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := first.Heartbeat(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Members()) != 1 {
+		t.Errorf("expected expired instance not to be a member, got %v", first.Members())
+	}
+
+	// instances that are long gone are deleted
+	_, err = dbClient.SchedulerInstance.Create().SetID("instance-4").SetHeartbeatAt(time.Now().Add(-time.Hour)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := first.Heartbeat(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ids, err := dbClient.SchedulerInstance.Query().IDs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 {
+		t.Errorf("expected the stale instance to be deleted and the expired one to be kept, got %v", ids)
+	}
+}
+
+type staticShardOwner []int
+
+func (o staticShardOwner) Shards() []int {
+	return o
+}
+
+func TestService_ProcessOwnedShards(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for shard := 0; shard < 4; shard++ {
+		_, err := dbClient.Task.Create().SetWebhookUrl("https://example.com").SetDueDate(time.Now().Add(-time.Second)).SetShard(shard).Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	q := &mockQueue{}
+	service := NewService(dbClient, q, nil, WithShardOwner(staticShardOwner{1, 3}))
+	if err := service.ProcessCurrentTasks(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.publishedTasks) != 2 {
+		t.Fatalf("expected to have 2 published tasks, got %d", len(q.publishedTasks))
+	}
+	pending, err := dbClient.Task.Query().Where(task.StatusEQ(task.StatusPending)).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ta := range pending {
+		if ta.Shard != 0 && ta.Shard != 2 {
+			t.Errorf("expected only tasks of shards 0 and 2 to be pending, got shard %d", ta.Shard)
+		}
+	}
+
+	// an instance without shards processes nothing
+	q = &mockQueue{}
+	service = NewService(dbClient, q, nil, WithShardOwner(staticShardOwner{}))
+	if err := service.ProcessCurrentTasks(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.publishedTasks) != 0 {
+		t.Errorf("expected to have 0 published tasks, got %d", len(q.publishedTasks))
+	}
+}
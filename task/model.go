@@ -1,16 +1,82 @@
 package task
 
 import (
+	"errors"
 	"fmt"
 	"time"
 )
 
+// DeliveryIDHeader is sent with every webhook request, it has the same value in all attempts of a fire
+const DeliveryIDHeader = "X-Timer-Delivery-Id"
+
 type Task struct {
-	ID         int       `json:"id"`
-	WebhookURL string    `json:"webhookUrl"`
-	DueDate    time.Time `json:"dueDate"`
+	ID           int               `json:"id"`
+	WebhookURL   string            `json:"webhookUrl"`
+	DueDate      time.Time         `json:"dueDate"`
+	Method       string            `json:"method,omitempty"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	Body         string            `json:"body,omitempty"`
+	OmitIDSuffix bool              `json:"omitIdSuffix,omitempty"` // don't append the task id to the webhook URL path
+	Cron         string            `json:"cron,omitempty"`
+	Retry        *RetryOverride    `json:"retry,omitempty"` // nil to use the service default
+	Attempts     int               `json:"attempts"`
+	DeliveryID   string            `json:"deliveryId,omitempty"` // set when the task is claimed for delivery
+	Status       string            `json:"status"`
+	Shard        int               `json:"shard"` // the instance that owns the shard schedules the task
+	// IdempotencyKey is unique per Caller, saving a task with a key that is already used returns the saved task
+	// when RequestHash matches
+	IdempotencyKey string    `json:"-"`
+	Caller         string    `json:"-"`
+	RequestHash    string    `json:"-"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// TaskChanges holds the editable fields of a task, nil fields are left unchanged
+type TaskChanges struct {
+	DueDate    *time.Time
+	WebhookURL *string
 }
 
+// TaskRun is a single run of a task, as recorded in its history
+type TaskRun struct {
+	Event      string // run, or reclaim when the lease of the task expired before it was delivered
+	RunAt      time.Time
+	Error      string
+	Attempt    int
+	HTTPStatus int // zero when no response was received
+	Latency    time.Duration
+}
+
+// TaskFilter filters the tasks returned by ListTasks, zero value fields are ignored
+type TaskFilter struct {
+	Statuses    []string
+	DueFrom     *time.Time
+	DueTo       *time.Time
+	WebhookHost string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	// AfterID is the pagination cursor, only tasks with greater id are returned
+	AfterID int
+	Limit   int
+}
+
+// DeadLetter is a queue message that could not be processed
+type DeadLetter struct {
+	ID     string
+	Body   string
+	Reason string
+	// Count is the number of times the message was dead lettered
+	Count  int
+	DeadAt time.Time
+}
+
+var (
+	// ErrAttemptsExhausted is returned when the last attempt to emit a task failed
+	ErrAttemptsExhausted  = errors.New("task attempts exhausted")
+	ErrDeadLetterNotFound = errors.New("dead letter not found")
+)
+
 type ApiError struct {
 	Code          int
 	Message       string
@@ -0,0 +1,213 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"github.com/Av1shay/timers-scheduler-demo/ent"
+	"github.com/Av1shay/timers-scheduler-demo/ent/outboxmessage"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/logx"
+	"time"
+)
+
+const (
+	// relayBatchSize is the max number of outbox messages published in a single batch
+	relayBatchSize = 100
+	// relayLease is how long a relay holds the messages it publishes, it has to be longer than publishing a batch
+	relayLease = time.Minute
+	// publishTimeout bounds the publish of a batch, or of a single message when the queue doesn't publish batches
+	publishTimeout = 10 * time.Second
+
+	// OutboxRelayInterval is how often RelayOutbox should run, it bounds the delay of messages that failed to be published
+	// or that were left by an instance that crashed after it claimed their tasks
+	OutboxRelayInterval = time.Second
+	// DefaultOutboxRetention is how long sent messages are kept before they are purged
+	DefaultOutboxRetention = time.Hour
+)
+
+// addToOutbox writes a queue message for every claimed task in the claim transaction, so a task is running
+// only if its message will be published
+func addToOutbox(ctx context.Context, tx *ent.Tx, tasks []*ent.Task) error {
+	creators := make([]*ent.OutboxMessageCreate, len(tasks))
+	for i, t := range tasks {
+		payload, err := json.Marshal(parseTask(t))
+		if err != nil {
+			return err
+		}
+		creators[i] = tx.OutboxMessage.Create().SetTaskId(t.ID).SetPayload(payload)
+	}
+	return tx.OutboxMessage.CreateBulk(creators...).Exec(ctx)
+}
+
+// RelayOutbox publishes the unsent outbox messages and marks them sent. Messages are leased for relayLease in a short
+// transaction with FOR UPDATE SKIP LOCKED, and published outside of it so no row locks are held while waiting for
+// the queue. Concurrent relays don't publish the same message, but a message may be published again if the relay
+// fails before it's marked sent. This is fine since a task is delivered only once it's claimed by EmitTask
+func (s *Service) RelayOutbox(ctx context.Context) error {
+	for {
+		count, err := s.relayOutboxBatch(ctx)
+		if err != nil {
+			return err
+		}
+		if count < relayBatchSize {
+			return nil
+		}
+	}
+}
+
+// relayOutboxBatch publishes up to relayBatchSize messages, and returns the number of messages it published.
+// Messages that could not be published are kept unsent with their error, and the relay stops until its next run
+func (s *Service) relayOutboxBatch(ctx context.Context) (int, error) {
+	messages, err := s.leaseOutboxMessages(ctx)
+	if err != nil || len(messages) == 0 {
+		return 0, err
+	}
+
+	publishErrs := s.publishOutboxMessages(ctx, messages)
+	tx, err := s.dbClient.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	sent := make([]int, 0, len(messages))
+	sentTasks := make([]int, 0, len(messages))
+	failed := 0
+	for i, publishErr := range publishErrs {
+		m := messages[i]
+		if publishErr != nil {
+			logx.Errorf(ctx, "failed to publish task %d: %v\n", m.TaskId, publishErr)
+			failed++
+			// the lease is released so the message is retried by the next run
+			err := tx.OutboxMessage.UpdateOne(m).
+				AddAttempts(1).
+				SetLastError(publishErr.Error()).
+				ClearRelayUntil().
+				Exec(ctx)
+			if err != nil {
+				return 0, rollback(tx, err)
+			}
+			continue
+		}
+		sent = append(sent, m.ID)
+		sentTasks = append(sentTasks, m.TaskId)
+	}
+	if len(sent) > 0 {
+		err := tx.OutboxMessage.Update().
+			Where(outboxmessage.IDIn(sent...)).
+			SetSentAt(time.Now().UTC()).
+			ClearRelayUntil().
+			Exec(ctx)
+		if err != nil {
+			return 0, rollback(tx, err)
+		}
+		// the lease starts when the message is published, so messages that waited in the outbox are not reclaimed
+		// before they are consumed
+		_, err = tx.Task.Update().
+			Where(task.IDIn(sentTasks...), task.StatusEQ(task.StatusRunning)).
+			SetLeaseExpiresAt(s.leaseExpiry()).
+			Save(ctx)
+		if err != nil {
+			return 0, rollback(tx, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	if failed > 0 {
+		return len(sent), fmt.Errorf("failed to publish %d of %d outbox messages", failed, len(messages))
+	}
+	return len(sent), nil
+}
+
+// leaseOutboxMessages returns up to relayBatchSize unsent messages that are not leased by another relay,
+// and leases them for relayLease
+func (s *Service) leaseOutboxMessages(ctx context.Context) ([]*ent.OutboxMessage, error) {
+	tx, err := s.dbClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n := time.Now().UTC()
+	messages, err := tx.OutboxMessage.
+		Query().
+		Where(
+			outboxmessage.SentAtIsNil(),
+			outboxmessage.Or(outboxmessage.RelayUntilIsNil(), outboxmessage.RelayUntilLT(n)),
+		).
+		Order(ent.Asc(outboxmessage.FieldID)).
+		Limit(relayBatchSize).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if len(messages) == 0 {
+		return nil, tx.Rollback()
+	}
+	ids := make([]int, len(messages))
+	for i, m := range messages {
+		ids[i] = m.ID
+	}
+	err = tx.OutboxMessage.Update().
+		Where(outboxmessage.IDIn(ids...)).
+		SetRelayUntil(n.Add(relayLease)).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	return messages, tx.Commit()
+}
+
+// publishOutboxMessages publishes the tasks of the messages, as a single batch if the queue is a BatchPublisher.
+// Returns the error of every message, nil when it was published
+func (s *Service) publishOutboxMessages(ctx context.Context, messages []*ent.OutboxMessage) []error {
+	errs := make([]error, len(messages))
+	tasks := make([]*Task, 0, len(messages))
+	// indexes maps the tasks to their messages, messages with invalid payload are not published
+	indexes := make([]int, 0, len(messages))
+	for i, m := range messages {
+		var t Task
+		if err := json.Unmarshal(m.Payload, &t); err != nil {
+			errs[i] = fmt.Errorf("invalid outbox payload: %w", err)
+			continue
+		}
+		logx.Info(ctx, "publishing task", t.ID)
+		tasks = append(tasks, &t)
+		indexes = append(indexes, i)
+	}
+
+	if batchPublisher, ok := s.queue.(BatchPublisher); ok {
+		ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+		defer cancel()
+		for i, err := range batchPublisher.PublishBatch(ctx, tasks) {
+			errs[indexes[i]] = err
+		}
+		return errs
+	}
+	for i, t := range tasks {
+		errs[indexes[i]] = s.publish(ctx, t)
+	}
+	return errs
+}
+
+func (s *Service) publish(ctx context.Context, t *Task) error {
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+	return s.queue.Publish(ctx, t)
+}
+
+// hasUnsentMessage returns whether the task has a message in the outbox that was not published yet
+func (s *Service) hasUnsentMessage(ctx context.Context, taskID int) (bool, error) {
+	return s.dbClient.OutboxMessage.
+		Query().
+		Where(outboxmessage.TaskId(taskID), outboxmessage.SentAtIsNil()).
+		Exist(ctx)
+}
+
+// PurgeOutbox deletes the messages that were sent before olderThan, and returns the number of deleted messages
+func (s *Service) PurgeOutbox(ctx context.Context, olderThan time.Duration) (int, error) {
+	return s.dbClient.OutboxMessage.
+		Delete().
+		Where(outboxmessage.SentAtLT(time.Now().UTC().Add(-olderThan))).
+		Exec(ctx)
+}
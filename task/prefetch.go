@@ -0,0 +1,185 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"github.com/Av1shay/timers-scheduler-demo/ent"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/logx"
+	"github.com/Av1shay/timers-scheduler-demo/timingwheel"
+	"sync"
+	"time"
+)
+
+const (
+	// the wheel spans wheelTick*wheelSize^wheelLevels, about 12 days
+	wheelTick   = time.Millisecond
+	wheelSize   = 64
+	wheelLevels = 5
+	// maxWheelSleep bounds the time the driver sleeps when the wheel is empty
+	maxWheelSleep = time.Second
+
+	// prefetchLimit is the max number of tasks loaded into the wheel by a single refill
+	prefetchLimit = 10000
+
+	// DefaultPrefetchWindow is how far ahead tasks are loaded into the wheel
+	DefaultPrefetchWindow = 5 * time.Minute
+	// PrefetchRefillInterval is how often RefillPrefetch should run, it bounds the delay of tasks that were changed
+	// by another instance or that were missed by the wheel
+	PrefetchRefillInterval = 10 * time.Second
+)
+
+// prefetcher keeps the pending tasks that are due in the prefetch window in a timing wheel, so they are fired
+// from memory instead of polling the DB every tick
+type prefetcher struct {
+	window time.Duration
+	wheel  *timingwheel.TimingWheel
+	// wake interrupts the sleep of the driver when a task is added, since it may be due before the driver wakes up
+	wake chan struct{}
+
+	mu sync.RWMutex
+	// windowEnd is the due date until which all pending tasks are in the wheel
+	windowEnd time.Time
+}
+
+// WithPrefetch loads the tasks that are due in the next window into an in memory timing wheel, see RunPrefetched
+func WithPrefetch(window time.Duration) Option {
+	return func(s *Service) {
+		s.prefetch = &prefetcher{
+			window: window,
+			wheel:  timingwheel.New(wheelTick, wheelSize, wheelLevels, time.Now()),
+			wake:   make(chan struct{}, 1),
+		}
+	}
+}
+
+// RefillPrefetch loads the pending tasks that are due in the prefetch window into the wheel, including tasks
+// that are already due. The window is extended before the tasks are read, so tasks saved during the refill
+// are added to the wheel by SaveTask
+func (s *Service) RefillPrefetch(ctx context.Context) error {
+	if s.prefetch == nil {
+		return nil
+	}
+	shardFilter, ok := s.shardFilter()
+	if !ok {
+		return nil
+	}
+	windowEnd := time.Now().UTC().Add(s.prefetch.window)
+	s.prefetch.setWindowEnd(windowEnd)
+
+	tasks, err := s.dbClient.Task.
+		Query().
+		Where(task.DueDateLT(windowEnd), task.StatusEQ(task.StatusPending)).
+		Where(shardFilter...).
+		Order(ent.Asc(task.FieldDueDate), ent.Asc(task.FieldID)).
+		Limit(prefetchLimit).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to prefetch tasks: %s", err)
+	}
+	for _, t := range tasks {
+		s.prefetch.add(t.ID, t.DueDate)
+	}
+	if len(tasks) == prefetchLimit {
+		// there may be more tasks due at the same time as the last one, those are loaded by the next refill
+		s.prefetch.setWindowEnd(tasks[len(tasks)-1].DueDate)
+	}
+	return nil
+}
+
+// RunPrefetched fires the tasks of the wheel when they are due until ctx is done, RefillPrefetch must be called
+// periodically to keep the wheel loaded. The driver sleeps until the next tick of the wheel that has tasks,
+// so tasks are fired within about a millisecond of their due date
+func (s *Service) RunPrefetched(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-s.prefetch.wake:
+			if !timer.Stop() {
+				<-timer.C
+			}
+		}
+
+		if ids := s.prefetch.wheel.Advance(time.Now()); len(ids) > 0 {
+			ctx := logx.ContextWithTraceID(ctx)
+			if err := s.processDueTasks(ctx, ids); err != nil {
+				logx.Error(ctx, err)
+			}
+		}
+
+		sleep := maxWheelSleep
+		if next, ok := s.prefetch.wheel.Next(); ok && time.Until(next) < sleep {
+			sleep = time.Until(next)
+		}
+		timer.Reset(sleep)
+	}
+}
+
+// processDueTasks claims the tasks that were fired by the wheel and publishes them through the outbox. Tasks that are no longer pending or due,
+// because they were claimed, rescheduled or cancelled by another instance, are skipped
+func (s *Service) processDueTasks(ctx context.Context, ids []int) error {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	for start := 0; start < len(ids); start += claimBatchSize {
+		end := start + claimBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		tasks, err := s.claimDueTasks(ctx, end-start, task.IDIn(ids[start:end]...))
+		if err != nil {
+			return fmt.Errorf("failed to claim tasks for proccesing: %s", err)
+		}
+		if len(tasks) > 0 {
+			s.relayClaimedTasks(ctx)
+		}
+	}
+	return nil
+}
+
+// schedule adds a pending task of an owned shard that is due in the prefetch window to the wheel, and removes it
+// otherwise. Tasks of other shards are left to the wheels of their owners, which load them on their next refill
+func (s *Service) schedule(id, shard int, dueDate time.Time, status task.Status) {
+	if s.prefetch == nil {
+		return
+	}
+	if status == task.StatusPending && dueDate.Before(s.prefetch.getWindowEnd()) && s.ownsShard(shard) {
+		s.prefetch.add(id, dueDate)
+		return
+	}
+	s.unschedule(id)
+}
+
+// unschedule removes a task from the wheel
+func (s *Service) unschedule(id int) {
+	if s.prefetch == nil {
+		return
+	}
+	s.prefetch.wheel.Remove(id)
+}
+
+// add adds the task to the wheel and wakes up the driver
+func (p *prefetcher) add(id int, dueDate time.Time) {
+	p.wheel.Add(id, dueDate)
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *prefetcher) setWindowEnd(t time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.windowEnd = t
+}
+
+func (p *prefetcher) getWindowEnd() time.Time {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.windowEnd
+}
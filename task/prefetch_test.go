@@ -0,0 +1,227 @@
+package task
+
+import (
+	"context"
+	"github.com/Av1shay/timers-scheduler-demo/ent"
+	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	_ "github.com/go-sql-driver/mysql"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestService_RunPrefetched(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := &mockQueue{}
+	service := NewService(dbClient, q, nil, WithPrefetch(time.Minute))
+
+	n := time.Now().UTC()
+	overdueTask, err := dbClient.Task.Create().SetWebhookUrl("https://overdue.com").SetDueDate(n.Add(-time.Minute)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	laterTask, err := dbClient.Task.Create().SetWebhookUrl("https://later.com").SetDueDate(n.Add(2 * time.Minute)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.RefillPrefetch(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if service.prefetch.wheel.Len() != 1 {
+		t.Fatalf("expected 1 task in the wheel, got %d", service.prefetch.wheel.Len())
+	}
+
+	// tasks saved in the window are added to the wheel directly, and removed when cancelled
+	savedTask, err := service.SaveTask(ctx, &Task{WebhookURL: "https://saved.com", DueDate: n.Add(time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancelledTask, err := service.SaveTask(ctx, &Task{WebhookURL: "https://cancelled.com", DueDate: n.Add(time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.CancelTask(ctx, cancelledTask.ID); err != nil {
+		t.Fatal(err)
+	}
+	if service.prefetch.wheel.Len() != 2 {
+		t.Fatalf("expected 2 tasks in the wheel, got %d", service.prefetch.wheel.Len())
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	service.RunPrefetched(runCtx)
+
+	published := make(map[int]bool)
+	for _, publishedTask := range q.publishedTasks {
+		published[publishedTask.ID] = true
+	}
+	if len(published) != 2 || !published[overdueTask.ID] || !published[savedTask.ID] {
+		t.Errorf("expected tasks %d and %d to be published, got %v", overdueTask.ID, savedTask.ID, published)
+	}
+	laterTask, err = dbClient.Task.Get(ctx, laterTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if laterTask.Status != task.StatusPending {
+		t.Errorf("expected task %d after the window to be pending, got %s", laterTask.ID, laterTask.Status)
+	}
+}
+
+type timedQueue struct {
+	mu          sync.Mutex
+	publishedAt map[int]time.Time
+}
+
+func (q *timedQueue) Publish(_ context.Context, task *Task) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.publishedAt[task.ID] = time.Now()
+	return nil
+}
+
+func TestService_RunPrefetchedMilliseconds(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := &timedQueue{publishedAt: make(map[int]time.Time)}
+	service := NewService(dbClient, q, nil, WithPrefetch(time.Minute))
+	if err := service.RefillPrefetch(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	go service.RunPrefetched(runCtx)
+
+	dueDates := make(map[int]time.Time)
+	n := time.Now()
+	for _, offset := range []time.Duration{250 * time.Millisecond, 420 * time.Millisecond, 1010 * time.Millisecond} {
+		savedTask, err := service.SaveTask(ctx, &Task{WebhookURL: "https://example.com", DueDate: n.Add(offset)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		dueDates[savedTask.ID] = savedTask.DueDate
+	}
+	<-runCtx.Done()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for id, dueDate := range dueDates {
+		publishedAt, ok := q.publishedAt[id]
+		if !ok {
+			t.Errorf("expected task %d to be published", id)
+			continue
+		}
+		// the claim and publish take a few milliseconds of their own
+		if late := publishedAt.Sub(dueDate); late < 0 || late > 50*time.Millisecond {
+			t.Errorf("expected task %d to be published right after %s, got %s", id, dueDate, publishedAt)
+		}
+	}
+}
+
+func TestService_RunPrefetchedShards(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// two instances that split the shards
+	var firstShards, secondShards staticShardOwner
+	for shard := 0; shard < NumShards; shard++ {
+		if shard%2 == 0 {
+			firstShards = append(firstShards, shard)
+		} else {
+			secondShards = append(secondShards, shard)
+		}
+	}
+	q := &timedQueue{publishedAt: make(map[int]time.Time)}
+	first := NewService(dbClient, q, nil, WithPrefetch(time.Minute), WithShardOwner(firstShards))
+	second := NewService(dbClient, q, nil, WithPrefetch(time.Minute), WithShardOwner(secondShards))
+	for _, service := range []*Service{first, second} {
+		if err := service.RefillPrefetch(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	go first.RunPrefetched(runCtx)
+	go second.RunPrefetched(runCtx)
+
+	// a task is saved to a shard of the instance that saved it, so it's fired from its wheel without waiting for a refill
+	dueDates := make(map[int]time.Time)
+	n := time.Now()
+	for _, service := range []*Service{first, second} {
+		savedTask, err := service.SaveTask(ctx, &Task{WebhookURL: "https://example.com", DueDate: n.Add(300 * time.Millisecond)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !service.ownsShard(savedTask.Shard) {
+			t.Errorf("expected task %d to be saved to a shard of the instance, got %d", savedTask.ID, savedTask.Shard)
+		}
+		dueDates[savedTask.ID] = savedTask.DueDate
+	}
+
+	// a task that is changed by an instance that doesn't own it is not added to its wheel
+	laterTask, err := first.SaveTask(ctx, &Task{WebhookURL: "https://example.com", DueDate: n.Add(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	laterDueDate := n.Add(30 * time.Second)
+	if _, err := second.UpdateTask(ctx, laterTask.ID, &TaskChanges{DueDate: &laterDueDate}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if second.prefetch.wheel.Remove(laterTask.ID) {
+		t.Errorf("expected task %d not to be added to the wheel of the second instance", laterTask.ID)
+	}
+	<-runCtx.Done()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for id, dueDate := range dueDates {
+		publishedAt, ok := q.publishedAt[id]
+		if !ok {
+			t.Errorf("expected task %d to be published", id)
+			continue
+		}
+		if late := publishedAt.Sub(dueDate); late < 0 || late > 50*time.Millisecond {
+			t.Errorf("expected task %d to be published right after %s, got %s", id, dueDate, publishedAt)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package task
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy controls how failed webhook calls are retried
+type RetryPolicy struct {
+	MaxAttempts int           `json:"maxAttempts,omitempty"`
+	BaseDelay   time.Duration `json:"baseDelay,omitempty"`
+	MaxDelay    time.Duration `json:"maxDelay,omitempty"`
+	// Jitter is the fraction of the delay that is randomized, between 0 and 1
+	Jitter float64 `json:"jitter,omitempty"`
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   10 * time.Second,
+	MaxDelay:    10 * time.Minute,
+	Jitter:      0.2,
+}
+
+// RetryOverride overrides the retry policy of a task, nil fields fall back to the service default
+// so an explicit zero, such as no jitter, is kept
+type RetryOverride struct {
+	MaxAttempts *int           `json:"maxAttempts,omitempty"`
+	BaseDelay   *time.Duration `json:"baseDelay,omitempty"`
+	MaxDelay    *time.Duration `json:"maxDelay,omitempty"`
+	Jitter      *float64       `json:"jitter,omitempty"`
+}
+
+// merge returns def with the fields that are set in the override
+func (o *RetryOverride) merge(def RetryPolicy) RetryPolicy {
+	if o == nil {
+		return def
+	}
+	merged := def
+	if o.MaxAttempts != nil {
+		merged.MaxAttempts = *o.MaxAttempts
+	}
+	if o.BaseDelay != nil {
+		merged.BaseDelay = *o.BaseDelay
+	}
+	if o.MaxDelay != nil {
+		merged.MaxDelay = *o.MaxDelay
+	}
+	if o.Jitter != nil {
+		merged.Jitter = *o.Jitter
+	}
+	return merged
+}
+
+// Backoff returns the delay before the next attempt after the given number of failed attempts,
+// the delay is doubled on every attempt up to MaxDelay and then reduced randomly by up to Jitter of it
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(attempts-1))
+	if delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	delay -= delay * p.Jitter * rand.Float64()
+	return time.Duration(delay)
+}
@@ -0,0 +1,45 @@
+package task
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 10, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+	for attempts, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 8 * time.Second, 5: 10 * time.Second, 9: 10 * time.Second} {
+		if got := p.Backoff(attempts); got != want {
+			t.Errorf("expected backoff after %d attempts to be %s, got %s", attempts, want, got)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := p.Backoff(2); got < time.Second || got > 2*time.Second {
+			t.Fatalf("expected backoff with jitter to be in range [1s,2s], got %s", got)
+		}
+	}
+}
+
+func TestRetryOverride_Merge(t *testing.T) {
+	var o *RetryOverride
+	if got := o.merge(DefaultRetryPolicy); got != DefaultRetryPolicy {
+		t.Errorf("expected nil override to use the default, got %+v", got)
+	}
+
+	maxAttempts, jitter := 2, 0.5
+	o = &RetryOverride{MaxAttempts: &maxAttempts, Jitter: &jitter}
+	want := RetryPolicy{MaxAttempts: 2, BaseDelay: DefaultRetryPolicy.BaseDelay, MaxDelay: DefaultRetryPolicy.MaxDelay, Jitter: 0.5}
+	if got := o.merge(DefaultRetryPolicy); got != want {
+		t.Errorf("expected merged policy to be %+v, got %+v", want, got)
+	}
+
+	// an explicit zero is kept, so jitter can be turned off for a task
+	noJitter := 0.0
+	o = &RetryOverride{Jitter: &noJitter}
+	want = DefaultRetryPolicy
+	want.Jitter = 0
+	if got := o.merge(DefaultRetryPolicy); got != want {
+		t.Errorf("expected merged policy to be %+v, got %+v", want, got)
+	}
+}
@@ -2,130 +2,393 @@ package task
 
 import (
 	"context"
+	"entgo.io/ent/dialect/sql"
 	"fmt"
 	"github.com/Av1shay/timers-scheduler-demo/ent"
+	"github.com/Av1shay/timers-scheduler-demo/ent/predicate"
 	"github.com/Av1shay/timers-scheduler-demo/ent/task"
+	"github.com/Av1shay/timers-scheduler-demo/ent/taskhistory"
 	"github.com/Av1shay/timers-scheduler-demo/logx"
+	"github.com/Av1shay/timers-scheduler-demo/webhooksig"
+	"github.com/google/uuid"
+	"io"
+	"math/rand"
 	"net/http"
+	"net/url"
 	"strings"
-	"sync"
 	"time"
 )
 
 type Service struct {
-	dbClient   *ent.Client
-	queue      Queue
-	httpClient *http.Client
+	dbClient    *ent.Client
+	queue       Queue
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
+	// signingSecrets are used to sign every webhook request, requests are not signed when empty
+	signingSecrets []string
+	leaseDuration  time.Duration
+	maxReclaims    int
+	// shardOwner limits the tasks the instance processes to its shards, all tasks are processed when nil
+	shardOwner ShardOwner
+	// prefetch fires the tasks from an in memory timing wheel when set, see WithPrefetch
+	prefetch *prefetcher
 }
 
+const (
+	// claimBatchSize is the max number of tasks claimed in a single transaction
+	claimBatchSize = 100
+
+	// DefaultLeaseDuration has to be longer than the time it takes a message to be consumed and its webhook to be called
+	DefaultLeaseDuration = 5 * time.Minute
+	DefaultMaxReclaims   = 3
+)
+
 type Queue interface {
 	Publish(ctx context.Context, task *Task) error
 }
 
-func NewService(dbClient *ent.Client, queue Queue, httpClient *http.Client) *Service {
-	return &Service{
-		dbClient,
-		queue,
-		httpClient,
+// Message is a task message received from a queue, it must be acked once it was handled or nacked
+type Message interface {
+	Body() []byte
+	// Ack removes the message from the queue
+	Ack() error
+	// Nack returns the message to the queue when requeue is true, and dead letters it otherwise
+	Nack(requeue bool) error
+}
+
+// Consumer delivers the messages of a queue to a handler, the handler may be called concurrently
+type Consumer interface {
+	Consume(handler func(m Message)) error
+}
+
+// BatchPublisher is implemented by queues that publish many tasks faster as a batch than one by one,
+// the outbox relay uses it when the queue implements it
+type BatchPublisher interface {
+	// PublishBatch returns the error of every task, nil when the task was published
+	PublishBatch(ctx context.Context, tasks []*Task) []error
+}
+
+// DeadLetterQueue manages the messages that could not be processed
+type DeadLetterQueue interface {
+	ListDeadLetters(ctx context.Context, limit int) ([]*DeadLetter, error)
+	GetDeadLetter(ctx context.Context, id string) (*DeadLetter, error)
+	ReplayDeadLetter(ctx context.Context, id string) error
+	PurgeDeadLetters(ctx context.Context) (int, error)
+}
+
+// Option configures optional behaviour of the Service
+type Option func(s *Service)
+
+// WithRetryPolicy sets the retry policy of tasks that don't have their own
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(s *Service) {
+		s.retryPolicy = p
+	}
+}
+
+// WithSigningSecrets signs webhook requests with every one of the secrets, see webhooksig package
+func WithSigningSecrets(secrets ...string) Option {
+	return func(s *Service) {
+		s.signingSecrets = secrets
+	}
+}
+
+// WithLease sets for how long a task can be running or delivering before it's reclaimed,
+// and how many times a task is reclaimed before it's failed
+func WithLease(d time.Duration, maxReclaims int) Option {
+	return func(s *Service) {
+		s.leaseDuration = d
+		s.maxReclaims = maxReclaims
+	}
+}
+
+// WithShardOwner processes only the tasks of the shards owned by o, see Membership
+func WithShardOwner(o ShardOwner) Option {
+	return func(s *Service) {
+		s.shardOwner = o
+	}
+}
+
+func NewService(dbClient *ent.Client, queue Queue, httpClient *http.Client, opts ...Option) *Service {
+	s := &Service{
+		dbClient:      dbClient,
+		queue:         queue,
+		httpClient:    httpClient,
+		retryPolicy:   DefaultRetryPolicy,
+		leaseDuration: DefaultLeaseDuration,
+		maxReclaims:   DefaultMaxReclaims,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
-// ProcessCurrentTasks process task with dueDate in current second
+// ProcessCurrentTasks claims every pending task that is due and publishes it. Tasks are claimed in batches with
+// FOR UPDATE SKIP LOCKED, so tasks of a skipped or late tick are picked by the next one, and overlapping ticks never claim
+// the same task twice. The queue messages of the claimed tasks are written to the outbox, and published by RelayOutbox
 func (s *Service) ProcessCurrentTasks(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 	defer cancel()
 
-	dueDate := time.Now().UTC().Truncate(time.Second)
-	tasks, err := s.dbClient.Task.
-		Query().
-		Where(task.DueDateEQ(dueDate), task.StatusEQ(task.StatusPending)).
-		All(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get tasks for proccesing: %s", err)
-	}
+	for {
+		tasks, err := s.claimDueTasks(ctx, claimBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to claim tasks for proccesing: %s", err)
+		}
+		if len(tasks) == 0 {
+			return nil
+		}
 
-	if len(tasks) == 0 {
-		return nil
+		s.relayClaimedTasks(ctx)
+
+		if len(tasks) < claimBatchSize {
+			return nil
+		}
 	}
+}
 
-	return s.processTasks(ctx, tasks)
+// relayClaimedTasks publishes the messages of tasks that were just claimed without waiting for the next relay run,
+// messages that could not be published are left to it
+func (s *Service) relayClaimedTasks(ctx context.Context) {
+	if err := s.RelayOutbox(ctx); err != nil {
+		logx.Error(ctx, "failed to relay outbox:", err)
+	}
 }
 
 // ProcessOldTasks finds and process any task with status PENDING that was not processed in time for some reason
 func (s *Service) ProcessOldTasks(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
-	defer cancel()
+	return s.ProcessCurrentTasks(ctx)
+}
 
-	tasks, err := s.dbClient.Task.
+// claimDueTasks moves up to limit pending tasks that are due and match the filters to running, and adds their messages
+// to the outbox in the same transaction. Rows that are locked by another claim are skipped
+func (s *Service) claimDueTasks(ctx context.Context, limit int, filters ...predicate.Task) ([]*ent.Task, error) {
+	shardFilter, ok := s.shardFilter()
+	if !ok {
+		return nil, nil
+	}
+	tx, err := s.dbClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := tx.Task.
 		Query().
 		Where(task.DueDateLTE(time.Now().UTC()), task.StatusEQ(task.StatusPending)).
+		Where(shardFilter...).
+		Where(filters...).
+		Order(ent.Asc(task.FieldDueDate), ent.Asc(task.FieldID)).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
 		All(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to old tasks for proccesing: %s", err)
+		return nil, rollback(tx, err)
 	}
-
 	if len(tasks) == 0 {
-		return nil
+		return nil, tx.Rollback()
 	}
 
-	return s.processTasks(ctx, tasks)
+	ids := make([]int, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.ID
+		t.Status = task.StatusRunning
+	}
+	_, err = tx.Task.Update().
+		Where(task.IDIn(ids...)).
+		SetStatus(task.StatusRunning).
+		SetLeaseExpiresAt(s.leaseExpiry()).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := addToOutbox(ctx, tx, tasks); err != nil {
+		return nil, rollback(tx, err)
+	}
+	return tasks, tx.Commit()
 }
 
-func (s *Service) processTasks(ctx context.Context, tasks []*ent.Task) error {
-	workers := 5
-	wg := sync.WaitGroup{}
-	wg.Add(workers)
-	tasksChan := make(chan *ent.Task, workers)
-
-	for i := 0; i < workers; i++ {
-		go func() {
-			defer wg.Done()
-
-			for t := range tasksChan {
-				logx.Info(ctx, "processing task", t.ID)
-
-				if err := s.processTask(ctx, t); err != nil {
-					logx.Errorf(ctx, "failed to process task %d: %v\n", t.ID, err)
-				}
+// SaveTask stores a new task, if the task has a cron expression its due date is the next activation of the expression
+func (s *Service) SaveTask(ctx context.Context, t *Task) (*Task, error) {
+	dueDate := t.DueDate
+	if t.Cron != "" {
+		next, err := nextCronRun(t.Cron, time.Now().UTC())
+		if err != nil {
+			return nil, &ApiError{400, err.Error(), fmt.Sprintf("invalid cron expression %q", t.Cron)}
+		}
+		dueDate = next
+	}
+	taskCreator := s.dbClient.Task.Create().
+		SetDueDate(dueDate.Truncate(time.Millisecond)).
+		SetWebhookUrl(t.WebhookURL).
+		SetWebhookHost(webhookHost(t.WebhookURL)).
+		SetHeaders(t.Headers).
+		SetBody(t.Body).
+		SetOmitIdSuffix(t.OmitIDSuffix).
+		SetCron(t.Cron).
+		SetShard(s.newShard())
+	if t.Method != "" {
+		taskCreator.SetMethod(t.Method)
+	}
+	if t.IdempotencyKey != "" {
+		existing, err := s.GetIdempotentTask(ctx, t)
+		if err != nil || existing != nil {
+			return existing, err
+		}
+		taskCreator.
+			SetCaller(t.Caller).
+			SetIdempotencyKey(t.IdempotencyKey).
+			SetRequestHash(t.RequestHash)
+	}
+	if t.Retry != nil {
+		taskCreator.
+			SetNillableMaxAttempts(t.Retry.MaxAttempts).
+			SetNillableRetryBaseDelayMs(nillableMilliseconds(t.Retry.BaseDelay)).
+			SetNillableRetryMaxDelayMs(nillableMilliseconds(t.Retry.MaxDelay)).
+			SetNillableRetryJitter(t.Retry.Jitter)
+	}
+	taskEnt, err := taskCreator.Save(ctx)
+	if err != nil {
+		// a concurrent request with the same idempotency key was saved first
+		if t.IdempotencyKey != "" && ent.IsConstraintError(err) {
+			if existing, err := s.GetIdempotentTask(ctx, t); err != nil || existing != nil {
+				return existing, err
 			}
-		}()
+		}
+		return nil, err
 	}
+	s.schedule(taskEnt.ID, taskEnt.Shard, taskEnt.DueDate, taskEnt.Status)
+	return parseTask(taskEnt), nil
+}
 
-	for _, t := range tasks {
-		tasksChan <- t
+// GetIdempotentTask returns the task saved with the caller and idempotency key of t, or nil if there is none.
+// Returns 409 error if the task was saved by a different request
+func (s *Service) GetIdempotentTask(ctx context.Context, t *Task) (*Task, error) {
+	taskEnt, err := s.dbClient.Task.Query().
+		Where(task.Caller(t.Caller), task.IdempotencyKey(t.IdempotencyKey)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
 	}
-
-	close(tasksChan)
-	wg.Wait()
-
-	return nil
+	if taskEnt.RequestHash != t.RequestHash {
+		msg := fmt.Sprintf("idempotency key %q was already used with a different request", t.IdempotencyKey)
+		return nil, &ApiError{409, fmt.Sprintf("%s by task %d of caller %q", msg, taskEnt.ID, t.Caller), msg}
+	}
+	return parseTask(taskEnt), nil
 }
 
-// processTask insert task to queue, wrap the insertion and status update in a transaction
-func (s *Service) processTask(ctx context.Context, t *ent.Task) error {
-	tx, err := s.dbClient.Tx(ctx)
+func (s *Service) GetTask(ctx context.Context, id int) (*Task, error) {
+	taskEnt, err := s.getTask(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return parseTask(taskEnt), nil
+}
+
+// ListTasks returns the tasks matching the filter ordered by id, and whether there are more tasks after them
+func (s *Service) ListTasks(ctx context.Context, f *TaskFilter) ([]*Task, bool, error) {
+	query := s.dbClient.Task.Query().Where(task.IDGT(f.AfterID))
+	if len(f.Statuses) > 0 {
+		statuses := make([]task.Status, len(f.Statuses))
+		for i, status := range f.Statuses {
+			statuses[i] = task.Status(status)
+			if err := task.StatusValidator(statuses[i]); err != nil {
+				return nil, false, &ApiError{400, err.Error(), fmt.Sprintf("invalid status %q", status)}
+			}
+		}
+		query.Where(task.StatusIn(statuses...))
+	}
+	if f.DueFrom != nil {
+		query.Where(task.DueDateGTE(*f.DueFrom))
 	}
-	_, err = tx.Task.UpdateOne(t).SetStatus(task.StatusRunning).Save(ctx)
+	if f.DueTo != nil {
+		query.Where(task.DueDateLT(*f.DueTo))
+	}
+	if f.WebhookHost != "" {
+		query.Where(task.WebhookHost(strings.ToLower(f.WebhookHost)))
+	}
+	if f.CreatedFrom != nil {
+		query.Where(task.CreatedAtGTE(*f.CreatedFrom))
+	}
+	if f.CreatedTo != nil {
+		query.Where(task.CreatedAtLT(*f.CreatedTo))
+	}
+
+	// fetch one extra task to know if there is another page
+	taskEnts, err := query.Order(ent.Asc(task.FieldID)).Limit(f.Limit + 1).All(ctx)
 	if err != nil {
-		return rollback(tx, err)
+		return nil, false, &ApiError{500, err.Error(), "something went wrong"}
 	}
-	if err := s.queue.Publish(ctx, parseTask(t)); err != nil {
-		return rollback(tx, err)
+	hasMore := len(taskEnts) > f.Limit
+	if hasMore {
+		taskEnts = taskEnts[:f.Limit]
+	}
+	tasks := make([]*Task, len(taskEnts))
+	for i, taskEnt := range taskEnts {
+		tasks[i] = parseTask(taskEnt)
+	}
+	return tasks, hasMore, nil
+}
+
+// BackfillWebhookHosts sets the webhook host of tasks that were created before the column was added, so filtering by host
+// finds them. Tasks are updated in batches ordered by id, tasks whose URL has no host keep an empty host
+func (s *Service) BackfillWebhookHosts(ctx context.Context) (int, error) {
+	const batchSize = 500
+	filled, afterID := 0, 0
+	for {
+		taskEnts, err := s.dbClient.Task.Query().
+			Where(task.WebhookHost(""), task.IDGT(afterID)).
+			Order(ent.Asc(task.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return filled, err
+		}
+		for _, taskEnt := range taskEnts {
+			host := webhookHost(taskEnt.WebhookUrl)
+			if host == "" {
+				continue
+			}
+			// conditional so a URL that was changed concurrently keeps its own host
+			n, err := s.dbClient.Task.Update().
+				Where(task.ID(taskEnt.ID), task.WebhookHost(""), task.WebhookUrl(taskEnt.WebhookUrl)).
+				SetWebhookHost(host).
+				Save(ctx)
+			if err != nil {
+				return filled, err
+			}
+			filled += n
+		}
+		if len(taskEnts) < batchSize {
+			return filled, nil
+		}
+		afterID = taskEnts[len(taskEnts)-1].ID
 	}
-	return tx.Commit()
 }
 
-func (s *Service) SaveTask(ctx context.Context, dueDate time.Time, webhookURL string) (*Task, error) {
-	taskEnt, err := s.dbClient.Task.Create().SetDueDate(dueDate.Truncate(time.Second)).SetWebhookUrl(webhookURL).Save(ctx)
+// GetTaskHistory returns the runs of a task, oldest first
+func (s *Service) GetTaskHistory(ctx context.Context, id int) ([]*TaskRun, error) {
+	taskEnt, err := s.getTask(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	return parseTask(taskEnt), nil
+	historyEnts, err := taskEnt.QueryHistories().
+		Order(ent.Asc(taskhistory.FieldRunAt), ent.Asc(taskhistory.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ApiError{500, err.Error(), "something went wrong"}
+	}
+	runs := make([]*TaskRun, len(historyEnts))
+	for i, h := range historyEnts {
+		runs[i] = parseTaskRun(h)
+	}
+	return runs, nil
 }
 
-func (s *Service) GetTask(ctx context.Context, id int) (*Task, error) {
+// getTask fetch task entity by id, errors are returned as ApiError
+func (s *Service) getTask(ctx context.Context, id int) (*ent.Task, error) {
 	taskEnt, err := s.dbClient.Task.Get(ctx, id)
 	if err != nil {
 		if _, ok := err.(*ent.NotFoundError); ok {
@@ -133,62 +396,476 @@ func (s *Service) GetTask(ctx context.Context, id int) (*Task, error) {
 		}
 		return nil, &ApiError{500, err.Error(), "something went wrong"}
 	}
-	return parseTask(taskEnt), nil
+	return taskEnt, nil
+}
+
+// UpdateTask edits a pending task. When version is given the task is updated only if its updated_at still equals it
+func (s *Service) UpdateTask(ctx context.Context, id int, changes *TaskChanges, version *time.Time) (*Task, error) {
+	taskUpdater := s.dbClient.Task.Update().Where(task.ID(id), task.StatusEQ(task.StatusPending))
+	if version != nil {
+		taskUpdater.Where(task.UpdatedAtEQ(*version))
+	}
+	if changes.DueDate != nil {
+		taskUpdater.SetDueDate(changes.DueDate.Truncate(time.Millisecond))
+	}
+	if changes.WebhookURL != nil {
+		taskUpdater.SetWebhookUrl(*changes.WebhookURL).SetWebhookHost(webhookHost(*changes.WebhookURL))
+	}
+	n, err := taskUpdater.Save(ctx)
+	if err != nil {
+		return nil, &ApiError{500, err.Error(), "something went wrong"}
+	}
+	if n > 0 {
+		updated, err := s.getTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		s.schedule(updated.ID, updated.Shard, updated.DueDate, updated.Status)
+		return parseTask(updated), nil
+	}
+
+	taskEnt, err := s.getTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if taskEnt.Status != task.StatusPending {
+		msg := fmt.Sprintf("task %d is already %s", id, taskEnt.Status)
+		return nil, &ApiError{409, msg, msg}
+	}
+	msg := fmt.Sprintf("task %d was modified at %s", id, taskEnt.UpdatedAt)
+	return nil, &ApiError{412, msg, fmt.Sprintf("task %d was modified, fetch it and try again", id)}
+}
+
+// CancelTask cancels a pending, running or delivering task, cancelled tasks are never emitted again
+func (s *Service) CancelTask(ctx context.Context, id int) error {
+	n, err := s.dbClient.Task.Update().
+		Where(task.ID(id), task.StatusIn(task.StatusPending, task.StatusRunning, task.StatusDelivering)).
+		SetStatus(task.StatusCancelled).
+		ClearLeaseExpiresAt().
+		Save(ctx)
+	if err != nil {
+		return &ApiError{500, err.Error(), "something went wrong"}
+	}
+	if n > 0 {
+		s.unschedule(id)
+		return nil
+	}
+	taskEnt, err := s.getTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	msg := fmt.Sprintf("task %d is already %s", id, taskEnt.Status)
+	return &ApiError{409, msg, msg}
 }
 
-// EmitTask send POST request to tasks webhook and update DB
-func (s *Service) EmitTask(ctx context.Context, t *Task) error {
-	err := s.emitTask(ctx, t)
-	if updateErr := s.updateTaskAfterRun(ctx, t, err); updateErr != nil {
+// EmitTask send POST request to tasks webhook and update DB.
+// Before calling the webhook the task is claimed by moving it from running to delivering, so messages of tasks that were
+// cancelled after being queued, and messages that are redelivered after the task was already emitted, are dropped.
+// A failed call is returned as ErrAttemptsExhausted only when the task failed for good, a call that will be retried,
+// or a failed run of a recurring task that was scheduled to its next activation, is not returned as an error
+func (s *Service) EmitTask(ctx context.Context, msg *Task) error {
+	t, err := s.claimTask(ctx, msg.ID)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		logx.Info(ctx, "task is not running, skipping", msg.ID)
+		return nil
+	}
+
+	res, err := s.emitTask(ctx, t)
+	status, updateErr := s.updateTaskAfterRun(ctx, t, res, err)
+	if updateErr != nil {
 		// we don't return error here because this is not a retriable error, we don't want to emit the task twice
 		logx.Errorf(ctx, "failed up update task %d after emitting error: %s\n", t.ID, updateErr)
 	}
+	if err == nil || updateErr != nil {
+		return err
+	}
+	if status == task.StatusFailed {
+		return fmt.Errorf("%w: %v", ErrAttemptsExhausted, err)
+	}
+	logx.Errorf(ctx, "failed to emit task %d, will run again: %s\n", t.ID, err)
+	return nil
+}
+
+// claimTask moves a running task to delivering and returns it with its delivery id, or nil if the task is not running.
+// The delivery id of a retried fire is kept so all of its attempts have the same id
+func (s *Service) claimTask(ctx context.Context, id int) (*Task, error) {
+	current, err := s.dbClient.Task.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	deliveryID := current.DeliveryId
+	if deliveryID == "" {
+		deliveryID = uuid.NewString()
+	}
+	n, err := s.dbClient.Task.Update().
+		Where(task.ID(id), task.StatusEQ(task.StatusRunning)).
+		SetStatus(task.StatusDelivering).
+		SetDeliveryId(deliveryID).
+		SetLeaseExpiresAt(s.leaseExpiry()).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return nil, err
+	}
+	t := parseTask(current)
+	t.Status = task.StatusDelivering.String()
+	t.DeliveryID = deliveryID
+	return t, nil
+}
+
+// ReviveTask moves a failed task back to running with no attempts, so its message can be emitted again
+// when it's replayed from the dead letter queue. Tasks that are not failed are left unchanged
+func (s *Service) ReviveTask(ctx context.Context, id int) error {
+	_, err := s.dbClient.Task.Update().
+		Where(task.ID(id), task.StatusEQ(task.StatusFailed)).
+		SetStatus(task.StatusRunning).
+		SetAttempts(0).
+		SetReclaims(0).
+		SetLeaseExpiresAt(s.leaseExpiry()).
+		Save(ctx)
 	return err
 }
 
-func (s *Service) emitTask(ctx context.Context, t *Task) error {
-	url := fmt.Sprintf("%s/%d", strings.TrimSuffix(t.WebhookURL, "/"), t.ID)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+// runResult holds the details of a single webhook call, kept in the task history
+type runResult struct {
+	startedAt  time.Time
+	latency    time.Duration
+	statusCode int // zero when no response was received
+}
+
+func (s *Service) emitTask(ctx context.Context, t *Task) (*runResult, error) {
+	res := &runResult{startedAt: time.Now().UTC()}
+	webhookURL := t.WebhookURL
+	if !t.OmitIDSuffix {
+		webhookURL = fmt.Sprintf("%s/%d", strings.TrimSuffix(t.WebhookURL, "/"), t.ID)
+	}
+	method := t.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	var body io.Reader
+	if t.Body != "" {
+		body = strings.NewReader(t.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, webhookURL, body)
 	if err != nil {
-		return err
+		return res, err
+	}
+	for name, value := range t.Headers {
+		req.Header.Set(name, value)
+	}
+	if t.Body != "" && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if t.DeliveryID != "" {
+		req.Header.Set(DeliveryIDHeader, t.DeliveryID)
+	}
+	if len(s.signingSecrets) > 0 {
+		webhooksig.SignRequest(req, []byte(t.Body), s.signingSecrets, time.Now())
 	}
 	resp, err := s.httpClient.Do(req)
+	res.latency = time.Since(res.startedAt)
 	if err != nil {
-		return err
+		return res, err
 	}
+	resp.Body.Close()
+	res.statusCode = resp.StatusCode
 	if resp.StatusCode >= http.StatusBadRequest { // TODO check if we should care about the response
-		return fmt.Errorf("status code: %d", resp.StatusCode)
+		return res, fmt.Errorf("status code: %d", resp.StatusCode)
 	}
-	return nil
+	return res, nil
 }
 
-// updateTaskAfterRun update task and add another entry to its history with optional error, to keep track on each run
-func (s *Service) updateTaskAfterRun(ctx context.Context, t *Task, runErr error) error {
+// updateTaskAfterRun update task and add another entry to its history with optional error, to keep track on each run.
+// Failed runs are retried by moving the task back to pending with a later due date, until the attempts of its retry policy
+// are exhausted and the task is failed. Recurring tasks are scheduled to the next activation of their cron expression
+// once the run succeeded or failed for good, and moved back to pending. Returns the status the task was moved to,
+// empty when the task was cancelled while its webhook was called
+func (s *Service) updateTaskAfterRun(ctx context.Context, t *Task, res *runResult, runErr error) (task.Status, error) {
 	tx, err := s.dbClient.Tx(ctx)
 	if err != nil {
-		return err
+		return "", err
+	}
+	n := time.Now().UTC()
+	attempt := t.Attempts + 1
+	policy := t.Retry.merge(s.retryPolicy)
+
+	// a task that was cancelled while its webhook was called keeps its status
+	taskUpdater := tx.Task.Update().
+		Where(task.ID(t.ID), task.StatusEQ(task.StatusDelivering)).
+		ClearLeaseExpiresAt().
+		SetReclaims(0)
+	var status task.Status
+	var nextDueDate time.Time // set when the task is moved back to pending
+	switch {
+	case runErr != nil && attempt < policy.MaxAttempts:
+		status = task.StatusPending
+		nextDueDate = n.Add(policy.Backoff(attempt)).Truncate(time.Millisecond)
+		taskUpdater.SetDueDate(nextDueDate).SetAttempts(attempt)
+	case t.Cron != "":
+		status = task.StatusPending
+		nextDueDate, err = nextCronRun(t.Cron, n)
+		if err != nil {
+			return "", rollback(tx, err)
+		}
+		taskUpdater.SetDueDate(nextDueDate).SetAttempts(0).ClearDeliveryId()
+	case runErr != nil:
+		status = task.StatusFailed
+		taskUpdater.SetAttempts(attempt)
+	default:
+		status = task.StatusDone
+		taskUpdater.SetAttempts(attempt)
 	}
-	updatedTask, err := tx.Task.UpdateOneID(t.ID).SetStatus(task.StatusDone).Save(ctx)
+	taskUpdater.SetStatus(status)
+	changed, err := taskUpdater.Save(ctx)
 	if err != nil {
-		return rollback(tx, err)
+		return "", rollback(tx, err)
+	}
+	taskHistoryCreator := tx.TaskHistory.Create().
+		SetTaskID(t.ID).
+		SetRunAt(res.startedAt).
+		SetAttempt(attempt).
+		SetLatencyMs(res.latency.Milliseconds())
+	if res.statusCode != 0 {
+		taskHistoryCreator.SetHttpStatus(res.statusCode)
 	}
-	taskHistoryCreator := tx.TaskHistory.Create().SetTask(updatedTask)
 	if runErr != nil {
 		taskHistoryCreator.SetError(runErr.Error())
 	}
 	_, err = taskHistoryCreator.Save(ctx)
+	if err != nil {
+		return "", rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	if changed == 0 {
+		return "", nil
+	}
+	if !nextDueDate.IsZero() {
+		s.schedule(t.ID, t.Shard, nextDueDate, task.StatusPending)
+	}
+	return status, nil
+}
+
+// ReclaimExpiredTasks returns running and delivering tasks whose lease expired to pending so they are processed again,
+// this happens when their queue message was lost or the consumer crashed before they were delivered.
+// Tasks that were reclaimed more than the max reclaims are failed, or moved to their next activation if they are cron
+// timers. Every reclaim is recorded in the task history
+func (s *Service) ReclaimExpiredTasks(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	shardFilter, ok := s.shardFilter()
+	if !ok {
+		return nil
+	}
+	tasks, err := s.dbClient.Task.
+		Query().
+		Where(task.StatusIn(task.StatusRunning, task.StatusDelivering), task.LeaseExpiresAtLT(time.Now().UTC())).
+		Where(shardFilter...).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tasks with expired lease: %s", err)
+	}
+
+	for _, t := range tasks {
+		if t.Status == task.StatusRunning {
+			// the message of the task is still waiting for the relay, reclaiming the task would only publish it again
+			unsent, err := s.hasUnsentMessage(ctx, t.ID)
+			if err != nil {
+				logx.Errorf(ctx, "failed to check outbox of task %d: %v\n", t.ID, err)
+				continue
+			}
+			if unsent {
+				continue
+			}
+		}
+		logx.Info(ctx, "reclaiming task", t.ID)
+		if err := s.reclaimTask(ctx, t); err != nil {
+			logx.Errorf(ctx, "failed to reclaim task %d: %v\n", t.ID, err)
+		}
+	}
+	return nil
+}
+
+// reclaimTask moves the task back to pending, or fails it when it has no reclaims left.
+// The update is conditional so a task that was delivered or claimed again after it was fetched is skipped
+func (s *Service) reclaimTask(ctx context.Context, t *ent.Task) error {
+	tx, err := s.dbClient.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	n := time.Now().UTC()
+	reclaims := t.Reclaims + 1
+	reason := fmt.Sprintf("lease expired while task was %s", t.Status)
+
+	taskUpdater := tx.Task.Update().
+		Where(task.ID(t.ID), task.StatusEQ(t.Status), task.LeaseExpiresAtEQ(*t.LeaseExpiresAt)).
+		ClearLeaseExpiresAt().
+		SetReclaims(reclaims)
+	dueDate := t.DueDate // set when the task is moved back to pending
+	switch {
+	case reclaims <= s.maxReclaims:
+		// the task is already due, so it's claimed again by the next ProcessCurrentTasks
+		taskUpdater.SetStatus(task.StatusPending)
+	case t.Cron != "":
+		// the activation is skipped, the timer keeps running from its next activation
+		dueDate, err = nextCronRun(t.Cron, n)
+		if err != nil {
+			return rollback(tx, err)
+		}
+		taskUpdater.SetStatus(task.StatusPending).SetDueDate(dueDate).SetReclaims(0).SetAttempts(0).ClearDeliveryId()
+		reason += ", no reclaims left, skipping to the next activation"
+	default:
+		dueDate = time.Time{}
+		taskUpdater.SetStatus(task.StatusFailed)
+		reason += ", no reclaims left"
+	}
+	changed, err := taskUpdater.Save(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if changed == 0 {
+		logx.Info(ctx, "task lease was renewed, skipping", t.ID)
+		return tx.Rollback()
+	}
+	_, err = tx.TaskHistory.Create().
+		SetTaskID(t.ID).
+		SetEvent(taskhistory.EventReclaim).
+		SetRunAt(n).
+		SetAttempt(t.Attempts + 1).
+		SetError(reason).
+		Save(ctx)
 	if err != nil {
 		return rollback(tx, err)
 	}
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	if !dueDate.IsZero() {
+		s.schedule(t.ID, t.Shard, dueDate, task.StatusPending)
+	}
+	return nil
+}
+
+// newShard returns the shard of a new task, one of the shards owned by the instance when it has any, so the task is
+// added to the wheel of the instance that saved it
+func (s *Service) newShard() int {
+	if s.shardOwner != nil {
+		if shards := s.shardOwner.Shards(); len(shards) > 0 {
+			return shards[rand.Intn(len(shards))]
+		}
+	}
+	return randomShard()
+}
+
+// ownsShard reports whether the instance processes the tasks of the shard
+func (s *Service) ownsShard(shard int) bool {
+	if s.shardOwner == nil {
+		return true
+	}
+	for _, owned := range s.shardOwner.Shards() {
+		if owned == shard {
+			return true
+		}
+	}
+	return false
+}
+
+// shardFilter returns the predicates of the tasks the instance processes, and false when it doesn't own any shard
+func (s *Service) shardFilter() ([]predicate.Task, bool) {
+	if s.shardOwner == nil {
+		return nil, true
+	}
+	shards := s.shardOwner.Shards()
+	if len(shards) == 0 {
+		return nil, false
+	}
+	return []predicate.Task{task.ShardIn(shards...)}, true
+}
+
+func (s *Service) leaseExpiry() time.Time {
+	return time.Now().UTC().Add(s.leaseDuration)
 }
 
 func parseTask(t *ent.Task) *Task {
-	return &Task{
-		ID:         t.ID,
-		WebhookURL: t.WebhookUrl,
-		DueDate:    t.DueDate,
+	parsed := &Task{
+		ID:           t.ID,
+		WebhookURL:   t.WebhookUrl,
+		Method:       t.Method,
+		Headers:      t.Headers,
+		Body:         t.Body,
+		OmitIDSuffix: t.OmitIdSuffix,
+		DueDate:      t.DueDate,
+		Cron:         t.Cron,
+		Retry:        parseRetryOverride(t),
+		Attempts:     t.Attempts,
+		DeliveryID:   t.DeliveryId,
+		Status:       t.Status.String(),
+		Shard:        t.Shard,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+		Caller:       t.Caller,
+		RequestHash:  t.RequestHash,
+	}
+	if t.IdempotencyKey != nil {
+		parsed.IdempotencyKey = *t.IdempotencyKey
+	}
+	return parsed
+}
+
+// parseRetryOverride returns the retry override of the task, nil when it uses the default policy
+func parseRetryOverride(t *ent.Task) *RetryOverride {
+	if t.MaxAttempts == nil && t.RetryBaseDelayMs == nil && t.RetryMaxDelayMs == nil && t.RetryJitter == nil {
+		return nil
+	}
+	o := &RetryOverride{MaxAttempts: t.MaxAttempts, Jitter: t.RetryJitter}
+	if t.RetryBaseDelayMs != nil {
+		d := time.Duration(*t.RetryBaseDelayMs) * time.Millisecond
+		o.BaseDelay = &d
+	}
+	if t.RetryMaxDelayMs != nil {
+		d := time.Duration(*t.RetryMaxDelayMs) * time.Millisecond
+		o.MaxDelay = &d
+	}
+	return o
+}
+
+func parseTaskRun(h *ent.TaskHistory) *TaskRun {
+	run := &TaskRun{
+		Event:   h.Event.String(),
+		RunAt:   h.RunAt,
+		Attempt: h.Attempt,
+		Latency: time.Duration(h.LatencyMs) * time.Millisecond,
+	}
+	if h.Error != nil {
+		run.Error = *h.Error
+	}
+	if h.HttpStatus != nil {
+		run.HTTPStatus = *h.HttpStatus
+	}
+	return run
+}
+
+// webhookHost returns the lower cased host of the webhook URL, used to filter tasks by their webhook
+func webhookHost(webhookURL string) string {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// nillableMilliseconds returns the duration in milliseconds, nil when it's not set
+func nillableMilliseconds(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
 	}
+	ms := d.Milliseconds()
+	return &ms
 }
 
 // rollback rolls back a transaction and combine original error with rollback error if occurred
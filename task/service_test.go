@@ -2,19 +2,29 @@ package task
 
 import (
 	"context"
+	"errors"
 	"github.com/Av1shay/timers-scheduler-demo/ent"
+	"github.com/Av1shay/timers-scheduler-demo/ent/outboxmessage"
 	"github.com/Av1shay/timers-scheduler-demo/ent/task"
 	"github.com/Av1shay/timers-scheduler-demo/logx"
+	"github.com/Av1shay/timers-scheduler-demo/webhooksig"
 	_ "github.com/go-sql-driver/mysql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 )
 
 type mockQueue struct {
+	mu             sync.Mutex
 	publishedTasks []*Task
 }
 
 func (q *mockQueue) Publish(_ context.Context, task *Task) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
 	q.publishedTasks = append(q.publishedTasks, task)
 	return nil
 }
@@ -35,12 +45,13 @@ func TestService_ProcessTasks(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	dueDate := time.Now().UTC().Truncate(time.Second)
+	// tasks due now and in the past, as if some ticks were skipped
+	n := time.Now().UTC().Truncate(time.Second)
 	taskNames := []string{"task1", "task2", "task3"}
 	tasks := make([]*ent.Task, 3)
 	taskIds := make([]int, 3)
 	for i, name := range taskNames {
-		ta, err := dbClient.Task.Create().SetWebhookUrl("https://" + name + ".com").SetDueDate(dueDate).Save(ctx)
+		ta, err := dbClient.Task.Create().SetWebhookUrl("https://" + name + ".com").SetDueDate(n.Add(-time.Duration(i) * time.Second)).Save(ctx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -51,8 +62,17 @@ func TestService_ProcessTasks(t *testing.T) {
 	q := &mockQueue{publishedTasks: make([]*Task, 0, 3)}
 	service := NewService(dbClient, q, nil)
 
-	// process tasks
-	_ = service.processTasks(ctx, tasks)
+	futureTask, err := dbClient.Task.Create().SetWebhookUrl("https://future.com").SetDueDate(n.Add(time.Minute)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// process tasks, the second tick has nothing left to claim
+	for i := 0; i < 2; i++ {
+		if err := service.ProcessCurrentTasks(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
 
 	// check the queue
 	if len(q.publishedTasks) != 3 {
@@ -81,9 +101,290 @@ func TestService_ProcessTasks(t *testing.T) {
 		t.Fatal(err)
 	}
 	for _, ta := range updatedTasks {
-		if ta.Status != task.StatusRunning {
-			t.Errorf("expected task %d to have status running, got %s", ta.ID, ta.Status)
+		if ta.Status != task.StatusRunning || ta.LeaseExpiresAt == nil {
+			t.Errorf("expected task %d to have status running with a lease, got %s", ta.ID, ta.Status)
+		}
+	}
+	futureTask, err = dbClient.Task.Get(ctx, futureTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if futureTask.Status != task.StatusPending {
+		t.Errorf("expected task %d to have status pending, got %s", futureTask.ID, futureTask.Status)
+	}
+}
+
+type failingQueue struct {
+	mu   sync.Mutex
+	down bool
+	mockQueue
+}
+
+func (q *failingQueue) Publish(ctx context.Context, task *Task) error {
+	q.mu.Lock()
+	down := q.down
+	q.mu.Unlock()
+	if down {
+		return errors.New("broker is down")
+	}
+	return q.mockQueue.Publish(ctx, task)
+}
+
+func TestService_ProcessTasksPublishFailure(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dueTask, err := dbClient.Task.Create().SetWebhookUrl("https://task.com").SetDueDate(time.Now().Add(-time.Second)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := &failingQueue{down: true}
+	service := NewService(dbClient, q, nil)
+	if err := service.ProcessCurrentTasks(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// the task stays claimed, and its message waits in the outbox
+	dueTask, err = dbClient.Task.Get(ctx, dueTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dueTask.Status != task.StatusRunning {
+		t.Errorf("expected task %d to be running, got %s", dueTask.ID, dueTask.Status)
+	}
+	message, err := dbClient.OutboxMessage.Query().Where(outboxmessage.TaskId(dueTask.ID)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.SentAt != nil || message.Attempts != 1 || message.LastError == nil {
+		t.Errorf("expected outbox message to be unsent with 1 failed attempt, got %+v", message)
+	}
+
+	// the task is not reclaimed while its message is unsent, even if its lease expired
+	err = dbClient.Task.UpdateOneID(dueTask.ID).SetLeaseExpiresAt(time.Now().Add(-time.Minute)).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service = NewService(dbClient, q, nil, WithLease(time.Minute, 0))
+	if err := service.ReclaimExpiredTasks(ctx); err != nil {
+		t.Fatal(err)
+	}
+	dueTask, err = dbClient.Task.Get(ctx, dueTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dueTask.Status != task.StatusRunning {
+		t.Errorf("expected task %d to stay running, got %s", dueTask.ID, dueTask.Status)
+	}
+
+	// the message is published by the relay once the broker is back
+	q.mu.Lock()
+	q.down = false
+	q.mu.Unlock()
+	service = NewService(dbClient, q, nil)
+	if err := service.RelayOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.publishedTasks) != 1 || q.publishedTasks[0].ID != dueTask.ID {
+		t.Fatalf("expected task %d to be published, got %v", dueTask.ID, q.publishedTasks)
+	}
+	message, err = dbClient.OutboxMessage.Get(ctx, message.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.SentAt == nil {
+		t.Error("expected outbox message to be sent")
+	}
+	if err := service.RelayOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.publishedTasks) != 1 {
+		t.Errorf("expected sent message not to be published again, got %d published tasks", len(q.publishedTasks))
+	}
+
+	purged, err := service.PurgeOutbox(ctx, -time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 {
+		t.Errorf("expected 1 purged message, got %d", purged)
+	}
+}
+
+// batchQueue publishes batches, and rejects the tasks in failIDs
+type batchQueue struct {
+	mockQueue
+	batchSizes []int
+	failIDs    map[int]bool
+}
+
+func (q *batchQueue) PublishBatch(ctx context.Context, tasks []*Task) []error {
+	q.batchSizes = append(q.batchSizes, len(tasks))
+	errs := make([]error, len(tasks))
+	for i, t := range tasks {
+		if q.failIDs[t.ID] {
+			errs[i] = errors.New("nacked")
+			continue
+		}
+		errs[i] = q.Publish(ctx, t)
+	}
+	return errs
+}
+
+func TestService_RelayOutboxBatch(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make([]int, 3)
+	for i := range ids {
+		dueTask, err := dbClient.Task.Create().SetWebhookUrl("https://task.com").SetDueDate(time.Now().Add(-time.Second)).Save(ctx)
+		if err != nil {
+			t.Fatal(err)
 		}
+		ids[i] = dueTask.ID
+	}
+
+	q := &batchQueue{failIDs: map[int]bool{ids[1]: true}}
+	service := NewService(dbClient, q, nil)
+	if err := service.ProcessCurrentTasks(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.batchSizes) != 1 || q.batchSizes[0] != 3 {
+		t.Errorf("expected tasks to be published in a single batch of 3, got %v", q.batchSizes)
+	}
+	if len(q.publishedTasks) != 2 {
+		t.Fatalf("expected to have 2 published tasks, got %d", len(q.publishedTasks))
+	}
+
+	unsent, err := dbClient.OutboxMessage.Query().Where(outboxmessage.SentAtIsNil()).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unsent) != 1 || unsent[0].TaskId != ids[1] {
+		t.Fatalf("expected only the message of task %d to be unsent, got %v", ids[1], unsent)
+	}
+	if unsent[0].RelayUntil != nil {
+		t.Errorf("expected the failed message to be released, got lease until %s", unsent[0].RelayUntil)
+	}
+
+	// a message that is leased by another relay is skipped until its lease expires
+	err = dbClient.OutboxMessage.UpdateOne(unsent[0]).SetRelayUntil(time.Now().Add(time.Minute)).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.failIDs = nil
+	if err := service.RelayOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.publishedTasks) != 2 {
+		t.Fatalf("expected leased message not to be published, got %d published tasks", len(q.publishedTasks))
+	}
+	err = dbClient.OutboxMessage.UpdateOne(unsent[0]).SetRelayUntil(time.Now().Add(-time.Second)).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.RelayOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.publishedTasks) != 3 {
+		t.Errorf("expected message to be published once its lease expired, got %d published tasks", len(q.publishedTasks))
+	}
+}
+
+// blockingQueue blocks every publish until release is closed
+type blockingQueue struct {
+	mockQueue
+	started chan struct{}
+	release chan struct{}
+}
+
+func (q *blockingQueue) Publish(ctx context.Context, task *Task) error {
+	select {
+	case q.started <- struct{}{}:
+	default:
+	}
+	<-q.release
+	return q.mockQueue.Publish(ctx, task)
+}
+
+func TestService_RelayOutboxWithoutLocks(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = dbClient.Task.Create().SetWebhookUrl("https://slow.com").SetDueDate(time.Now().Add(-time.Second)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slowQueue := &blockingQueue{started: make(chan struct{}, 1), release: make(chan struct{})}
+	slowService := NewService(dbClient, slowQueue, nil)
+	relayed := make(chan error, 1)
+	go func() {
+		relayed <- slowService.ProcessCurrentTasks(ctx)
+	}()
+	select {
+	case <-slowQueue.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the relay to publish")
+	}
+
+	// tasks are claimed and relayed by another instance while the first relay waits for its queue
+	dueTask, err := dbClient.Task.Create().SetWebhookUrl("https://fast.com").SetDueDate(time.Now().Add(-time.Second)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := &mockQueue{}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := NewService(dbClient, q, nil).ProcessCurrentTasks(timeoutCtx); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.publishedTasks) != 1 || q.publishedTasks[0].ID != dueTask.ID {
+		t.Errorf("expected only task %d to be published while the other message is leased, got %v", dueTask.ID, q.publishedTasks)
+	}
+
+	close(slowQueue.release)
+	if err := <-relayed; err != nil {
+		t.Fatal(err)
+	}
+	if len(slowQueue.publishedTasks) != 1 {
+		t.Errorf("expected the slow relay to publish 1 task, got %d", len(slowQueue.publishedTasks))
 	}
 }
 
@@ -147,11 +448,649 @@ func TestService_ProcessOldTasks(t *testing.T) {
 	}
 }
 
-func clearDb(ctx context.Context, dbClient *ent.Client) {
-	if _, err := dbClient.TaskHistory.Delete().Exec(ctx); err != nil {
-		logx.Error(ctx, "failed to delete TaskHistory data")
+func TestService_ProcessTasksMultipleInstances(t *testing.T) {
+	ctx := context.Background()
+
+	// every instance has its own connection pool, as if it was running in a different process
+	dbClients := make([]*ent.Client, 2)
+	for i := range dbClients {
+		dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer dbClient.Close()
+		dbClients[i] = dbClient
 	}
-	if _, err := dbClient.Task.Delete().Exec(ctx); err != nil {
-		logx.Error(ctx, "failed to delete Task data")
+
+	defer clearDb(ctx, dbClients[0])
+
+	err := dbClients[0].Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tasksCount := 250
+	n := time.Now().UTC()
+	for i := 0; i < tasksCount; i++ {
+		_, err := dbClients[0].Task.Create().SetWebhookUrl("https://example.com").SetDueDate(n.Add(-time.Duration(i) * time.Millisecond)).Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	queues := make([]*mockQueue, len(dbClients))
+	wg := sync.WaitGroup{}
+	for i, dbClient := range dbClients {
+		queues[i] = &mockQueue{}
+		service := NewService(dbClient, queues[i], nil)
+		// overlapping ticks of every instance
+		for j := 0; j < 3; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := service.ProcessCurrentTasks(ctx); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	published := make(map[int]int)
+	for _, q := range queues {
+		for _, publishedTask := range q.publishedTasks {
+			published[publishedTask.ID]++
+		}
+	}
+	if len(published) != tasksCount {
+		t.Errorf("expected %d tasks to be published, got %d", tasksCount, len(published))
+	}
+	for id, count := range published {
+		if count != 1 {
+			t.Errorf("expected task %d to be published once, got %d", id, count)
+		}
+	}
+}
+
+func TestService_EmitCronTask(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer webhook.Close()
+
+	service := NewService(dbClient, nil, webhook.Client())
+
+	cronTask, err := dbClient.Task.Create().
+		SetWebhookUrl(webhook.URL).
+		SetDueDate(time.Now().UTC().Truncate(time.Second)).
+		SetCron("* * * * *").
+		SetStatus(task.StatusRunning).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.EmitTask(ctx, parseTask(cronTask)); err != nil {
+		t.Fatal(err)
+	}
+
+	cronTaskInDB, err := dbClient.Task.Get(ctx, cronTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cronTaskInDB.Status != task.StatusPending {
+		t.Errorf("expected task %d to have status pending, got %s", cronTaskInDB.ID, cronTaskInDB.Status)
+	}
+	if !cronTaskInDB.DueDate.After(cronTask.DueDate) {
+		t.Errorf("expected task %d to be rescheduled after %s, got %s", cronTaskInDB.ID, cronTask.DueDate, cronTaskInDB.DueDate)
+	}
+	histories, err := cronTaskInDB.QueryHistories().Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if histories != 1 {
+		t.Errorf("expected task %d to have 1 history entry, got %d", cronTaskInDB.ID, histories)
+	}
+}
+
+func TestService_EmitCronTaskAttemptsExhausted(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer webhook.Close()
+
+	service := NewService(dbClient, nil, webhook.Client())
+
+	cronTask, err := dbClient.Task.Create().
+		SetWebhookUrl(webhook.URL).
+		SetDueDate(time.Now().UTC().Truncate(time.Second)).
+		SetCron("* * * * *").
+		SetStatus(task.StatusRunning).
+		SetMaxAttempts(1).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the task runs again on its next activation, so its message must not be dead lettered
+	if err := service.EmitTask(ctx, parseTask(cronTask)); err != nil {
+		t.Fatalf("expected emit of rescheduled cron task not to return error, got %v", err)
+	}
+	cronTaskInDB, err := dbClient.Task.Get(ctx, cronTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cronTaskInDB.Status != task.StatusPending || cronTaskInDB.Attempts != 0 {
+		t.Errorf("expected task %d to be pending with no attempts, got %s after %d", cronTaskInDB.ID, cronTaskInDB.Status, cronTaskInDB.Attempts)
+	}
+}
+
+func TestService_CancelTask(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhookCalls := 0
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhookCalls++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer webhook.Close()
+
+	q := &mockQueue{publishedTasks: make([]*Task, 0, 1)}
+	service := NewService(dbClient, q, webhook.Client())
+
+	// cancelled task is not processed
+	pendingTask, err := dbClient.Task.Create().SetWebhookUrl(webhook.URL).SetDueDate(time.Now().Add(-5 * time.Second)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.CancelTask(ctx, pendingTask.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.ProcessOldTasks(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.publishedTasks) != 0 {
+		t.Errorf("expected to have 0 published tasks, got %d", len(q.publishedTasks))
+	}
+
+	// task cancelled after it was queued is not emitted
+	runningTask, err := dbClient.Task.Create().SetWebhookUrl(webhook.URL).SetDueDate(time.Now()).SetStatus(task.StatusRunning).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.CancelTask(ctx, runningTask.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.EmitTask(ctx, parseTask(runningTask)); err != nil {
+		t.Fatal(err)
+	}
+	if webhookCalls != 0 {
+		t.Errorf("expected webhook not to be called, got %d calls", webhookCalls)
+	}
+
+	// done task cannot be cancelled
+	doneTask, err := dbClient.Task.Create().SetWebhookUrl(webhook.URL).SetDueDate(time.Now()).SetStatus(task.StatusDone).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = service.CancelTask(ctx, doneTask.ID)
+	if apiErr, ok := err.(*ApiError); !ok || apiErr.Code != 409 {
+		t.Errorf("expected 409 api error, got %v", err)
+	}
+}
+
+func TestService_BackfillWebhookHosts(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewService(dbClient, nil, http.DefaultClient)
+
+	// tasks created before the host column was added have an empty host
+	oldTask, err := dbClient.Task.Create().
+		SetWebhookUrl("https://Example.com/hook").
+		SetDueDate(time.Now().UTC()).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = service.SaveTask(ctx, &Task{WebhookURL: "https://other.com/hook", DueDate: time.Now().UTC().Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filled, err := service.BackfillWebhookHosts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filled != 1 {
+		t.Errorf("expected 1 task to be backfilled, got %d", filled)
+	}
+	tasks, _, err := service.ListTasks(ctx, &TaskFilter{WebhookHost: "example.com", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 || tasks[0].ID != oldTask.ID {
+		t.Errorf("expected to find task %d by its host, got %v", oldTask.ID, tasks)
+	}
+	if filled, err := service.BackfillWebhookHosts(ctx); err != nil || filled != 0 {
+		t.Errorf("expected second backfill not to change tasks, got %d, %v", filled, err)
+	}
+}
+
+func TestService_EmitTaskRetries(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer webhook.Close()
+
+	service := NewService(dbClient, nil, webhook.Client(), WithRetryPolicy(RetryPolicy{MaxAttempts: 5, BaseDelay: time.Minute, MaxDelay: time.Hour}))
+
+	failingTask, err := dbClient.Task.Create().
+		SetWebhookUrl(webhook.URL).
+		SetDueDate(time.Now().UTC().Truncate(time.Second)).
+		SetStatus(task.StatusRunning).
+		SetMaxAttempts(2).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// first attempt is rescheduled
+	if err := service.EmitTask(ctx, parseTask(failingTask)); err != nil {
+		t.Fatalf("expected retried emit not to return error, got %v", err)
+	}
+	failingTask, err = dbClient.Task.Get(ctx, failingTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failingTask.Status != task.StatusPending || failingTask.Attempts != 1 {
+		t.Errorf("expected task %d to be pending after 1 attempt, got %s after %d", failingTask.ID, failingTask.Status, failingTask.Attempts)
+	}
+	if failingTask.DueDate.Before(time.Now().Add(30 * time.Second)) {
+		t.Errorf("expected task %d to be rescheduled with backoff, got %s", failingTask.ID, failingTask.DueDate)
+	}
+
+	// second attempt exhausts the task's policy
+	failingTask, err = failingTask.Update().SetStatus(task.StatusRunning).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.EmitTask(ctx, parseTask(failingTask)); !errors.Is(err, ErrAttemptsExhausted) {
+		t.Fatalf("expected emit to fail with %v, got %v", ErrAttemptsExhausted, err)
+	}
+	failingTask, err = dbClient.Task.Get(ctx, failingTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failingTask.Status != task.StatusFailed || failingTask.Attempts != 2 {
+		t.Errorf("expected task %d to be failed after 2 attempts, got %s after %d", failingTask.ID, failingTask.Status, failingTask.Attempts)
+	}
+
+	runs, err := service.GetTaskHistory(ctx, failingTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 2 {
+		t.Fatalf("expected task %d to have 2 history entries, got %d", failingTask.ID, len(runs))
+	}
+	for i, run := range runs {
+		if run.Attempt != i+1 || run.HTTPStatus != http.StatusInternalServerError || run.Error == "" {
+			t.Errorf("unexpected run %+v", run)
+		}
+	}
+}
+
+func TestService_EmitTaskRequest(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotReq *http.Request
+	var gotBody []byte
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer webhook.Close()
+
+	service := NewService(dbClient, nil, webhook.Client())
+
+	savedTask, err := service.SaveTask(ctx, &Task{
+		WebhookURL:   webhook.URL + "/hooks/rounds",
+		DueDate:      time.Now(),
+		Method:       http.MethodPut,
+		Headers:      map[string]string{"Authorization": "Bearer secret"},
+		Body:         `{"round":1}`,
+		OmitIDSuffix: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dbClient.Task.UpdateOneID(savedTask.ID).SetStatus(task.StatusRunning).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.EmitTask(ctx, savedTask); err != nil {
+		t.Fatal(err)
+	}
+
+	if gotReq == nil {
+		t.Fatal("expected webhook to be called")
+	}
+	if gotReq.Method != http.MethodPut || gotReq.URL.Path != "/hooks/rounds" {
+		t.Errorf("expected PUT /hooks/rounds, got %s %s", gotReq.Method, gotReq.URL.Path)
+	}
+	if gotReq.Header.Get("Authorization") != "Bearer secret" || gotReq.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("unexpected headers %v", gotReq.Header)
+	}
+	if string(gotBody) != `{"round":1}` {
+		t.Errorf("expected body to be %s, got %s", `{"round":1}`, gotBody)
+	}
+}
+
+func TestService_EmitSignedTask(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var verifyErr error
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verifyErr = webhooksig.VerifyRequest(r, "old-secret")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer webhook.Close()
+
+	service := NewService(dbClient, nil, webhook.Client(), WithSigningSecrets("new-secret", "old-secret"))
+
+	savedTask, err := service.SaveTask(ctx, &Task{WebhookURL: webhook.URL, DueDate: time.Now(), Body: `{"hello":"world"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dbClient.Task.UpdateOneID(savedTask.ID).SetStatus(task.StatusRunning).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.EmitTask(ctx, savedTask); err != nil {
+		t.Fatal(err)
+	}
+	if verifyErr != nil {
+		t.Errorf("expected webhook request to be verified, got %v", verifyErr)
+	}
+}
+
+func TestService_EmitTaskOnce(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var deliveryIDs []string
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deliveryIDs = append(deliveryIDs, r.Header.Get(DeliveryIDHeader))
+		if len(deliveryIDs) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer webhook.Close()
+
+	service := NewService(dbClient, nil, webhook.Client())
+
+	runningTask, err := dbClient.Task.Create().SetWebhookUrl(webhook.URL).SetDueDate(time.Now()).SetStatus(task.StatusRunning).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := parseTask(runningTask)
+
+	// first attempt fails and is retried, the redelivered message is dropped since the task is no longer running
+	for i := 0; i < 2; i++ {
+		if err := service.EmitTask(ctx, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(deliveryIDs) != 1 {
+		t.Fatalf("expected webhook to be called once, got %d calls", len(deliveryIDs))
+	}
+
+	// the retry has the delivery id of the first attempt, and redelivery of its message is dropped as well
+	if err := dbClient.Task.UpdateOneID(runningTask.ID).SetStatus(task.StatusRunning).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := service.EmitTask(ctx, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(deliveryIDs) != 2 {
+		t.Fatalf("expected webhook to be called twice, got %d calls", len(deliveryIDs))
+	}
+	if deliveryIDs[0] == "" || deliveryIDs[0] != deliveryIDs[1] {
+		t.Errorf("expected both attempts to have the same delivery id, got %v", deliveryIDs)
+	}
+
+	taskInDB, err := dbClient.Task.Get(ctx, runningTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if taskInDB.Status != task.StatusDone || taskInDB.DeliveryId != deliveryIDs[0] {
+		t.Errorf("expected task to be done with delivery id %s, got %s with %s", deliveryIDs[0], taskInDB.Status, taskInDB.DeliveryId)
+	}
+}
+
+func TestService_ReclaimExpiredTasks(t *testing.T) {
+	ctx := context.Background()
+
+	dbClient, err := ent.Open("mysql", "user:password@tcp(localhost:3320)/task_scheduler?parseTime=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbClient.Close()
+
+	defer clearDb(ctx, dbClient)
+
+	err = dbClient.Schema.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewService(dbClient, nil, nil, WithLease(time.Minute, 2))
+
+	n := time.Now().UTC()
+	expiredTask, err := dbClient.Task.Create().
+		SetWebhookUrl("https://example.com").
+		SetDueDate(n.Add(-10 * time.Minute)).
+		SetStatus(task.StatusRunning).
+		SetLeaseExpiresAt(n.Add(-time.Minute)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exhaustedTask, err := dbClient.Task.Create().
+		SetWebhookUrl("https://example.com").
+		SetDueDate(n.Add(-10 * time.Minute)).
+		SetStatus(task.StatusDelivering).
+		SetLeaseExpiresAt(n.Add(-time.Minute)).
+		SetReclaims(2).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leasedTask, err := dbClient.Task.Create().
+		SetWebhookUrl("https://example.com").
+		SetDueDate(n.Add(-10 * time.Minute)).
+		SetStatus(task.StatusRunning).
+		SetLeaseExpiresAt(n.Add(time.Minute)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exhaustedCronTask, err := dbClient.Task.Create().
+		SetWebhookUrl("https://example.com").
+		SetDueDate(n.Add(-10 * time.Minute)).
+		SetCron("*/5 * * * *").
+		SetStatus(task.StatusDelivering).
+		SetLeaseExpiresAt(n.Add(-time.Minute)).
+		SetReclaims(2).
+		SetDeliveryId("delivery-1").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.ReclaimExpiredTasks(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		id           int
+		wantStatus   task.Status
+		wantReclaims int
+	}{
+		{expiredTask.ID, task.StatusPending, 1},
+		{exhaustedTask.ID, task.StatusFailed, 3},
+		{leasedTask.ID, task.StatusRunning, 0},
+		{exhaustedCronTask.ID, task.StatusPending, 0},
+	} {
+		taskInDB, err := dbClient.Task.Get(ctx, tt.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if taskInDB.Status != tt.wantStatus || taskInDB.Reclaims != tt.wantReclaims {
+			t.Errorf("expected task %d to be %s with %d reclaims, got %s with %d", tt.id, tt.wantStatus, tt.wantReclaims, taskInDB.Status, taskInDB.Reclaims)
+		}
+	}
+
+	// the cron timer is moved to its next activation instead of failing for good
+	cronTaskInDB, err := dbClient.Task.Get(ctx, exhaustedCronTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cronTaskInDB.DueDate.After(n) || cronTaskInDB.DueDate.After(n.Add(5*time.Minute)) || cronTaskInDB.DeliveryId != "" {
+		t.Errorf("expected cron task to be due at its next activation without a delivery id, got %s with %q", cronTaskInDB.DueDate, cronTaskInDB.DeliveryId)
+	}
+
+	runs, err := service.GetTaskHistory(ctx, expiredTask.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || runs[0].Event != "reclaim" || runs[0].Error == "" {
+		t.Errorf("expected a reclaim history entry, got %+v", runs)
+	}
+}
+
+func clearDb(ctx context.Context, dbClient *ent.Client) {
+	if _, err := dbClient.OutboxMessage.Delete().Exec(ctx); err != nil {
+		logx.Error(ctx, "failed to delete OutboxMessage data")
+	}
+	if _, err := dbClient.TaskHistory.Delete().Exec(ctx); err != nil {
+		logx.Error(ctx, "failed to delete TaskHistory data")
+	}
+	if _, err := dbClient.Task.Delete().Exec(ctx); err != nil {
+		logx.Error(ctx, "failed to delete Task data")
+	}
+	if _, err := dbClient.SchedulerInstance.Delete().Exec(ctx); err != nil {
+		logx.Error(ctx, "failed to delete SchedulerInstance data")
 	}
 }
@@ -0,0 +1,230 @@
+// Package timingwheel implements a hierarchical timing wheel. The first level has a bucket per tick, and every
+// level above it has buckets that span a whole round of the level below. An item is kept in the lowest level
+// that covers its expiration, and moves down a level when the bucket it's in is reached.
+// Adding and removing items is O(1), and advancing the wheel is O(1) per tick plus the items it moves.
+package timingwheel
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+type item struct {
+	id         int
+	expiration int64 // in ticks
+}
+
+type location struct {
+	level  int
+	bucket int
+}
+
+// TimingWheel holds ids with their expiration time, it's safe for concurrent use
+type TimingWheel struct {
+	mu        sync.Mutex
+	tick      time.Duration
+	wheelSize int64
+	levels    [][]map[int]*item
+	index     map[int]location
+	// expired holds the items that expired when they were added, returned by the next Advance
+	expired map[int]*item
+	current int64 // in ticks, every item that expires at or before it was returned
+}
+
+// New creates a wheel with the given levels of wheelSize buckets each, starting at now.
+// It spans tick*wheelSize^levels, items that expire after it are kept in the last level until they are in range
+func New(tick time.Duration, wheelSize, levels int, now time.Time) *TimingWheel {
+	tw := &TimingWheel{
+		tick:      tick,
+		wheelSize: int64(wheelSize),
+		levels:    make([][]map[int]*item, levels),
+		index:     make(map[int]location),
+		expired:   make(map[int]*item),
+	}
+	for l := range tw.levels {
+		tw.levels[l] = make([]map[int]*item, wheelSize)
+		for b := range tw.levels[l] {
+			tw.levels[l][b] = make(map[int]*item)
+		}
+	}
+	tw.current = tw.ticks(now)
+	return tw
+}
+
+// Add schedules id to expire at the given time, replacing its previous expiration if it was already added
+func (tw *TimingWheel) Add(id int, at time.Time) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	tw.remove(id)
+	tw.add(&item{id: id, expiration: tw.ticks(at)})
+}
+
+// Remove removes id from the wheel, returns false if it was not in the wheel
+func (tw *TimingWheel) Remove(id int) bool {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	return tw.remove(id)
+}
+
+// Len returns the number of ids in the wheel
+func (tw *TimingWheel) Len() int {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	return len(tw.index) + len(tw.expired)
+}
+
+// Next returns the time of the next tick at which Advance returns items or moves them down a level, and false when
+// the wheel is empty. Sleeping until it lets a driver advance the wheel precisely without waking up on every tick
+func (tw *TimingWheel) Next() (time.Time, bool) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	if len(tw.expired) > 0 {
+		return tw.time(tw.current), true
+	}
+	next := int64(-1)
+	interval := int64(1)
+	for l := range tw.levels {
+		for k := int64(1); k <= tw.wheelSize; k++ {
+			t := (tw.current/interval + k) * interval
+			if next != -1 && t >= next {
+				break
+			}
+			if len(tw.levels[l][(t/interval)%tw.wheelSize]) > 0 {
+				next = t
+				break
+			}
+		}
+		interval *= tw.wheelSize
+	}
+	if next == -1 {
+		return time.Time{}, false
+	}
+	return tw.time(next), true
+}
+
+// Advance moves the wheel to now, and returns the ids that expired ordered by their expiration
+func (tw *TimingWheel) Advance(now time.Time) []int {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	expired := make([]*item, 0, len(tw.expired))
+	for id, it := range tw.expired {
+		expired = append(expired, it)
+		delete(tw.expired, id)
+	}
+
+	target := tw.ticks(now)
+	if target-tw.current > tw.span() {
+		// the wheel would go around more than once, it's cheaper to re-add all the items
+		items := make([]*item, 0, len(tw.index))
+		for id, loc := range tw.index {
+			items = append(items, tw.levels[loc.level][loc.bucket][id])
+			tw.remove(id)
+		}
+		tw.current = target
+		for _, it := range items {
+			if it.expiration <= target {
+				expired = append(expired, it)
+			} else {
+				tw.add(it)
+			}
+		}
+	}
+	for tw.current < target {
+		tw.current++
+		expired = tw.advanceTick(expired)
+	}
+
+	sort.Slice(expired, func(i, j int) bool {
+		if expired[i].expiration != expired[j].expiration {
+			return expired[i].expiration < expired[j].expiration
+		}
+		return expired[i].id < expired[j].id
+	})
+	ids := make([]int, len(expired))
+	for i, it := range expired {
+		ids[i] = it.id
+	}
+	return ids
+}
+
+// advanceTick moves down the items of the buckets that start at the current tick, and appends the expired items
+func (tw *TimingWheel) advanceTick(expired []*item) []*item {
+	interval := tw.wheelSize
+	for l := 1; l < len(tw.levels); l++ {
+		if tw.current%interval != 0 {
+			break
+		}
+		bucket := int((tw.current / interval) % tw.wheelSize)
+		for id, it := range tw.levels[l][bucket] {
+			tw.remove(id)
+			if it.expiration <= tw.current {
+				expired = append(expired, it)
+			} else {
+				tw.add(it)
+			}
+		}
+		interval *= tw.wheelSize
+	}
+
+	bucket := int(tw.current % tw.wheelSize)
+	for id, it := range tw.levels[0][bucket] {
+		if it.expiration <= tw.current {
+			tw.remove(id)
+			expired = append(expired, it)
+		}
+	}
+	return expired
+}
+
+func (tw *TimingWheel) add(it *item) {
+	if it.expiration <= tw.current {
+		tw.expired[it.id] = it
+		return
+	}
+	// the lowest level whose bucket of the expiration is reached in the current round of the level
+	level, interval := 0, int64(1)
+	for level < len(tw.levels)-1 && it.expiration/interval-tw.current/interval >= tw.wheelSize {
+		level++
+		interval *= tw.wheelSize
+	}
+	bucket := int((it.expiration / interval) % tw.wheelSize)
+	tw.levels[level][bucket][it.id] = it
+	tw.index[it.id] = location{level, bucket}
+}
+
+func (tw *TimingWheel) remove(id int) bool {
+	if _, ok := tw.expired[id]; ok {
+		delete(tw.expired, id)
+		return true
+	}
+	loc, ok := tw.index[id]
+	if !ok {
+		return false
+	}
+	delete(tw.levels[loc.level][loc.bucket], id)
+	delete(tw.index, id)
+	return true
+}
+
+// span returns the number of ticks the wheel covers
+func (tw *TimingWheel) span() int64 {
+	span := int64(1)
+	for range tw.levels {
+		span *= tw.wheelSize
+	}
+	return span
+}
+
+func (tw *TimingWheel) ticks(t time.Time) int64 {
+	return t.UnixNano() / int64(tw.tick)
+}
+
+func (tw *TimingWheel) time(ticks int64) time.Time {
+	return time.Unix(0, ticks*int64(tw.tick))
+}
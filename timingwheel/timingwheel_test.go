@@ -0,0 +1,134 @@
+package timingwheel
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestTimingWheel_Advance(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	tw := New(time.Second, 4, 3, start)
+
+	tw.Add(1, start.Add(3*time.Second))
+	tw.Add(2, start.Add(time.Second))
+	tw.Add(3, start.Add(30*time.Second)) // in the last level
+	tw.Add(4, start.Add(-time.Minute))   // already expired
+	tw.Add(5, start.Add(time.Second))
+	if tw.Len() != 5 {
+		t.Fatalf("expected 5 items, got %d", tw.Len())
+	}
+
+	if got := tw.Advance(start); !reflect.DeepEqual(got, []int{4}) {
+		t.Errorf("expected expired item to be returned by the first advance, got %v", got)
+	}
+	if got := tw.Advance(start.Add(2 * time.Second)); !reflect.DeepEqual(got, []int{2, 5}) {
+		t.Errorf("expected [2 5], got %v", got)
+	}
+	if got := tw.Advance(start.Add(29 * time.Second)); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("expected [1], got %v", got)
+	}
+	if got := tw.Advance(start.Add(30 * time.Second)); !reflect.DeepEqual(got, []int{3}) {
+		t.Errorf("expected [3], got %v", got)
+	}
+	if tw.Len() != 0 {
+		t.Errorf("expected empty wheel, got %d items", tw.Len())
+	}
+}
+
+func TestTimingWheel_AddAndRemove(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	tw := New(time.Second, 4, 3, start)
+
+	tw.Add(1, start.Add(5*time.Second))
+	tw.Add(1, start.Add(10*time.Second)) // rescheduled
+	tw.Add(2, start.Add(5*time.Second))
+	if !tw.Remove(2) {
+		t.Error("expected item 2 to be removed")
+	}
+	if tw.Remove(3) {
+		t.Error("expected item 3 not to be found")
+	}
+
+	if got := tw.Advance(start.Add(9 * time.Second)); len(got) != 0 {
+		t.Errorf("expected no items, got %v", got)
+	}
+	if got := tw.Advance(start.Add(10 * time.Second)); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("expected [1], got %v", got)
+	}
+}
+
+// every item is returned exactly by the first advance that passes its expiration, including items beyond the span of the wheel
+func TestTimingWheel_Random(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	tick := 10 * time.Millisecond
+	tw := New(tick, 8, 3, start) // spans 5.12s
+
+	rnd := rand.New(rand.NewSource(1))
+	expirations := make(map[int]time.Time)
+	for id := 0; id < 2000; id++ {
+		at := start.Add(time.Duration(rnd.Int63n(int64(20 * time.Second))))
+		expirations[id] = at
+		tw.Add(id, at)
+	}
+
+	now := start
+	for len(expirations) > 0 {
+		now = now.Add(time.Duration(rnd.Int63n(int64(300 * time.Millisecond))))
+		if rnd.Intn(50) == 0 {
+			now = now.Add(10 * time.Second) // a long pause
+		}
+
+		var want []int
+		for id, at := range expirations {
+			if at.UnixNano()/int64(tick) <= now.UnixNano()/int64(tick) {
+				want = append(want, id)
+				delete(expirations, id)
+			}
+		}
+		got := tw.Advance(now)
+		sort.Ints(want)
+		sort.Ints(got)
+		if len(want) != len(got) || (len(want) > 0 && !reflect.DeepEqual(want, got)) {
+			t.Fatalf("advance to %s: expected %v, got %v", now, want, got)
+		}
+	}
+}
+
+func TestTimingWheel_Next(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	tw := New(time.Millisecond, 8, 3, start)
+
+	if _, ok := tw.Next(); ok {
+		t.Error("expected empty wheel to have no next tick")
+	}
+
+	// advancing to the next tick until the item expires never skips it
+	at := start.Add(300 * time.Millisecond)
+	tw.Add(1, at)
+	for i := 0; ; i++ {
+		next, ok := tw.Next()
+		if !ok {
+			t.Fatal("expected the wheel to have a next tick")
+		}
+		if next.After(at) {
+			t.Fatalf("expected next tick not to be after the expiration %s, got %s", at, next)
+		}
+		if ids := tw.Advance(next); len(ids) > 0 {
+			if !next.Equal(at) {
+				t.Errorf("expected item to expire at %s, got %s", at, next)
+			}
+			break
+		}
+		if i > 3 {
+			t.Fatalf("expected the item to expire after a few ticks, got %d ticks", i)
+		}
+	}
+
+	tw.Add(2, start)
+	if next, ok := tw.Next(); !ok || next.After(at) {
+		t.Errorf("expected expired item to be returned by the next advance, got %s", next)
+	}
+}
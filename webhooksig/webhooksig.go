@@ -0,0 +1,98 @@
+// Package webhooksig signs the webhook requests sent by the scheduler, and helps receivers to verify them.
+//
+// Every request carries the unix timestamp it was signed at in TimestampHeader, and a comma separated list of
+// "v1=<hex HMAC-SHA256 of timestamp.body>" signatures in SignatureHeader, one for every active secret, so secrets can be
+// rotated by adding the new secret to the scheduler, moving the receivers to it and then removing the old one.
+package webhooksig
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Timer-Signature"
+	TimestampHeader = "X-Timer-Timestamp"
+
+	// DefaultTolerance is the max age of a request accepted by VerifyRequest, limits replay of captured requests
+	DefaultTolerance = 5 * time.Minute
+
+	signaturePrefix = "v1="
+)
+
+var (
+	ErrMissingHeaders   = errors.New("webhook signature headers are missing")
+	ErrInvalidTimestamp = errors.New("webhook timestamp is invalid or outside of tolerance")
+	ErrInvalidSignature = errors.New("webhook signature doesn't match")
+)
+
+// Sign returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>" with the secret
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignRequest sets the timestamp and signature headers of a request with the given body, signed with every secret
+func SignRequest(req *http.Request, body []byte, secrets []string, now time.Time) {
+	timestamp := now.Unix()
+	signatures := make([]string, len(secrets))
+	for i, secret := range secrets {
+		signatures[i] = signaturePrefix + Sign(secret, timestamp, body)
+	}
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, strings.Join(signatures, ","))
+}
+
+// Verify checks that the headers have a signature of the body made with one of the secrets,
+// and that the signing time is within tolerance of now
+func Verify(header http.Header, body []byte, secrets []string, tolerance time.Duration, now time.Time) error {
+	timestampHeader, signatureHeader := header.Get(TimestampHeader), header.Get(SignatureHeader)
+	if timestampHeader == "" || signatureHeader == "" {
+		return ErrMissingHeaders
+	}
+	timestamp, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	if age := now.Sub(time.Unix(timestamp, 0)); age > tolerance || age < -tolerance {
+		return ErrInvalidTimestamp
+	}
+
+	for _, secret := range secrets {
+		expected := []byte(Sign(secret, timestamp, body))
+		for _, signature := range strings.Split(signatureHeader, ",") {
+			signature = strings.TrimPrefix(strings.TrimSpace(signature), signaturePrefix)
+			if hmac.Equal(expected, []byte(signature)) {
+				return nil
+			}
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// VerifyRequest verifies an incoming webhook request with DefaultTolerance. The body is read and restored,
+// so the handler can still read it afterwards
+func VerifyRequest(r *http.Request, secrets ...string) error {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return Verify(r.Header, body, secrets, DefaultTolerance, time.Now())
+}
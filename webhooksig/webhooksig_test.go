@@ -0,0 +1,65 @@
+package webhooksig
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"round":1}`)
+	n := time.Now()
+
+	req := httptest.NewRequest(http.MethodPost, "/hook", nil)
+	SignRequest(req, body, []string{"new-secret", "old-secret"}, n)
+
+	// receivers with either secret accept the request
+	for _, secret := range []string{"new-secret", "old-secret"} {
+		if err := Verify(req.Header, body, []string{secret}, DefaultTolerance, n); err != nil {
+			t.Errorf("expected request to be verified with %s, got %v", secret, err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		body    []byte
+		secrets []string
+		now     time.Time
+		want    error
+	}{
+		{"wrong secret", body, []string{"other-secret"}, n, ErrInvalidSignature},
+		{"tampered body", []byte(`{"round":2}`), []string{"new-secret"}, n, ErrInvalidSignature},
+		{"expired", body, []string{"new-secret"}, n.Add(DefaultTolerance + time.Second), ErrInvalidTimestamp},
+	}
+	for _, tt := range tests {
+		if err := Verify(req.Header, tt.body, tt.secrets, DefaultTolerance, tt.now); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+
+	if err := Verify(http.Header{}, body, []string{"new-secret"}, DefaultTolerance, n); !errors.Is(err, ErrMissingHeaders) {
+		t.Errorf("expected %v, got %v", ErrMissingHeaders, err)
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	body := `{"round":1}`
+	req := httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(body))
+	SignRequest(req, []byte(body), []string{"secret"}, time.Now())
+
+	if err := VerifyRequest(req, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	// body is still readable by the handler
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != body {
+		t.Errorf("expected body to be %s, got %s", body, b)
+	}
+}
@@ -0,0 +1,154 @@
+// Package workerpool runs the consumers of the queue backends that pull their messages, such as db_queue and
+// redis_queue. Messages are fetched only when workers are idle, so a message is not held by a consumer that can't
+// handle it yet, and every message is handled by its own goroutine
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"github.com/Av1shay/timers-scheduler-demo/logx"
+	"github.com/Av1shay/timers-scheduler-demo/task"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultWorkers = 10
+	// errorDelay is how long the consumer waits after a failed fetch, so it doesn't spin while the backend is down
+	errorDelay = time.Second
+)
+
+// ErrClosed is returned when consuming from a pool that was shut down
+var ErrClosed = errors.New("queue is closed")
+
+// Fetch returns up to n messages, it may block for a while when there are none
+type Fetch func(ctx context.Context, n int) ([]task.Message, error)
+
+type Pool struct {
+	workers int
+	// pollInterval is how long the consumer waits when a fetch returned fewer messages than idle workers
+	pollInterval time.Duration
+
+	// wake interrupts the wait of the consumers, see Wake
+	wake     chan struct{}
+	closed   chan struct{}
+	shutdown sync.Once
+	// inFlight tracks the consumers and their handlers, Shutdown waits for them to finish
+	inFlight sync.WaitGroup
+}
+
+// New creates a pool whose consumers handle workers messages concurrently. When a fetch returns fewer messages than
+// the idle workers, the next fetch waits for pollInterval or a Wake
+func New(workers int, pollInterval time.Duration) *Pool {
+	return &Pool{
+		workers:      workers,
+		pollInterval: pollInterval,
+		wake:         make(chan struct{}, 1),
+		closed:       make(chan struct{}),
+	}
+}
+
+// Wake lets a waiting consumer fetch right away, called when messages are published by the same process
+func (p *Pool) Wake() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Consume starts a consumer that calls handler for every message returned by fetch from a pool of workers,
+// so handler is called concurrently
+func (p *Pool) Consume(fetch Fetch, handler func(m task.Message)) error {
+	select {
+	case <-p.closed:
+		return ErrClosed
+	default:
+	}
+
+	p.inFlight.Add(1)
+	go func() {
+		defer p.inFlight.Done()
+		p.consume(fetch, handler)
+	}()
+	return nil
+}
+
+func (p *Pool) consume(fetch Fetch, handler func(m task.Message)) {
+	ctx := context.Background()
+	idle := make(chan struct{}, p.workers)
+	for i := 0; i < p.workers; i++ {
+		idle <- struct{}{}
+	}
+
+	for {
+		// wait for an idle worker, and take all the others that are idle
+		select {
+		case <-p.closed:
+			return
+		case <-idle:
+		}
+		n := 1
+	takeIdle:
+		for n < p.workers {
+			select {
+			case <-idle:
+				n++
+			default:
+				break takeIdle
+			}
+		}
+
+		msgs, err := fetch(ctx, n)
+		if err != nil {
+			logx.Error(ctx, "failed to fetch queue messages:", err)
+		}
+		for i := len(msgs); i < n; i++ {
+			idle <- struct{}{}
+		}
+		for _, m := range msgs {
+			p.inFlight.Add(1)
+			go func(m task.Message) {
+				defer func() {
+					idle <- struct{}{}
+					p.inFlight.Done()
+				}()
+				handler(m)
+			}(m)
+		}
+
+		var delay time.Duration
+		switch {
+		case err != nil:
+			delay = errorDelay
+		case len(msgs) < n:
+			delay = p.pollInterval
+		}
+		if delay > 0 {
+			select {
+			case <-p.closed:
+				return
+			case <-p.wake:
+			case <-time.After(delay):
+			}
+		}
+	}
+}
+
+// Shutdown stops consuming and waits for the messages that are being handled until ctx is done
+func (p *Pool) Shutdown(ctx context.Context) error {
+	p.shutdown.Do(func() {
+		close(p.closed)
+	})
+
+	drained := make(chan struct{})
+	go func() {
+		p.inFlight.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
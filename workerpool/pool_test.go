@@ -0,0 +1,178 @@
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"github.com/Av1shay/timers-scheduler-demo/task"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type mockMessage struct {
+	body []byte
+}
+
+func (m *mockMessage) Body() []byte {
+	return m.body
+}
+
+func (m *mockMessage) Ack() error {
+	return nil
+}
+
+func (m *mockMessage) Nack(bool) error {
+	return nil
+}
+
+// mockSource returns the messages that were added to it, and records the number of messages asked by every fetch
+type mockSource struct {
+	mu    sync.Mutex
+	msgs  []task.Message
+	asked []int
+	err   error
+}
+
+func (s *mockSource) add(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := 0; i < n; i++ {
+		s.msgs = append(s.msgs, &mockMessage{body: []byte(strconv.Itoa(len(s.msgs)))})
+	}
+}
+
+func (s *mockSource) fetch(_ context.Context, n int) ([]task.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.asked = append(s.asked, n)
+	if s.err != nil {
+		return nil, s.err
+	}
+	if n > len(s.msgs) {
+		n = len(s.msgs)
+	}
+	msgs := s.msgs[:n]
+	s.msgs = s.msgs[n:]
+	return msgs, nil
+}
+
+func TestPool_Consume(t *testing.T) {
+	ctx := context.Background()
+	p := New(3, time.Hour)
+	source := &mockSource{}
+	source.add(3)
+
+	started := sync.WaitGroup{}
+	started.Add(3)
+	release := make(chan struct{})
+	handled := make(chan string, 10)
+	err := p.Consume(source.fetch, func(m task.Message) {
+		started.Done()
+		<-release
+		handled <- string(m.Body())
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every worker holds a message, so the handlers can only return once all of them started
+	allStarted := make(chan struct{})
+	go func() {
+		started.Wait()
+		close(allStarted)
+	}()
+	select {
+	case <-allStarted:
+	case <-time.After(time.Second):
+		t.Fatal("expected 3 handlers to run concurrently")
+	}
+
+	// messages that are published while the consumer waits are fetched after a wake
+	started.Add(2)
+	source.add(2)
+	close(release)
+	p.Wake()
+	for i := 0; i < 5; i++ {
+		select {
+		case <-handled:
+		case <-time.After(time.Second):
+			t.Fatalf("expected 5 handled messages, got %d", i)
+		}
+	}
+	if err := p.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Consume(source.fetch, func(m task.Message) {}); err != ErrClosed {
+		t.Errorf("expected consume after shutdown to fail with %v, got %v", ErrClosed, err)
+	}
+
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	if source.asked[0] != 3 {
+		t.Errorf("expected the first fetch to ask for a message for every worker, got %d", source.asked[0])
+	}
+	for _, n := range source.asked {
+		if n < 1 || n > 3 {
+			t.Errorf("expected fetches to ask for 1 to 3 messages, got %v", source.asked)
+			break
+		}
+	}
+}
+
+func TestPool_FetchError(t *testing.T) {
+	ctx := context.Background()
+	p := New(2, 0)
+	source := &mockSource{err: errors.New("backend is down")}
+
+	if err := p.Consume(source.fetch, func(m task.Message) {}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if err := p.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// the consumer waits after a failed fetch instead of retrying right away
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	if len(source.asked) != 1 {
+		t.Errorf("expected 1 fetch while the backend is down, got %d", len(source.asked))
+	}
+}
+
+func TestPool_Shutdown(t *testing.T) {
+	ctx := context.Background()
+	p := New(2, time.Hour)
+	source := &mockSource{}
+	source.add(2)
+
+	started := sync.WaitGroup{}
+	started.Add(2)
+	release := make(chan struct{})
+	finished := make(chan struct{}, 2)
+	err := p.Consume(source.fetch, func(m task.Message) {
+		started.Done()
+		<-release
+		finished <- struct{}{}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	started.Wait()
+
+	// shutdown times out while the messages are being handled
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if err := p.Shutdown(timeoutCtx); err != context.DeadlineExceeded {
+		t.Errorf("expected shutdown to time out, got %v", err)
+	}
+
+	close(release)
+	if err := p.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(finished) != 2 {
+		t.Errorf("expected 2 messages to be handled before shutdown returned, got %d", len(finished))
+	}
+}